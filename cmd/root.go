@@ -2,23 +2,33 @@ package cmd
 
 import (
 	"fmt"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/alias"
 	"github.com/dalet-oss/opensearch-cli/internal/cli/autofollow"
 	"github.com/dalet-oss/opensearch-cli/internal/cli/ccr"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/cluster"
 	"github.com/dalet-oss/opensearch-cli/internal/cli/ctx"
+	doccmd "github.com/dalet-oss/opensearch-cli/internal/cli/doc"
 	"github.com/dalet-oss/opensearch-cli/internal/cli/index"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/ism"
 	"github.com/dalet-oss/opensearch-cli/internal/cli/replication"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/rest"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/search"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/security"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/snapshot"
 	"github.com/dalet-oss/opensearch-cli/internal/cli/stats"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/tasks"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/template"
 	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/config"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
 	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 	"os"
 	"path"
 	"strings"
-
-	"github.com/spf13/cobra"
 )
 
 var log = logging.Logger()
@@ -34,7 +44,7 @@ Some commands supports the operations with the wildcard(due to it's not availabl
 	`, gu.WildHelp),
 	Run: func(cmd *cobra.Command, args []string) {
 		// init things
-		if flagutils.GetStringFlag(cmd.Flags(), consts.ConfigFlag) == "" {
+		if config.ConfigPath(cmd) == "" {
 			config.Init(false)
 		}
 		if flagutils.GetBoolFlag(cmd.Flags(), consts.VersionFlag) {
@@ -59,19 +69,34 @@ func Execute() {
 func init() {
 	// global flags
 	rootCmd.PersistentFlags().Bool(consts.VersionFlag, false, "show version")
-	rootCmd.PersistentFlags().String(consts.ConfigFlag, "", "config file (default is $HOME/.dalet/oscli/config)")
+	rootCmd.PersistentFlags().String(consts.ConfigFlag, "",
+		fmt.Sprintf("config file or the list of files separated by '%c' to merge (default is $%s or $HOME/.dalet/oscli/config)", os.PathListSeparator, consts.EnvConfig))
+	rootCmd.PersistentFlags().String(consts.ContextFlag, "",
+		fmt.Sprintf("context to use for this command instead of the current one (default is $%s)", consts.EnvContext))
 	rootCmd.PersistentFlags().String(consts.VaultPasswordFlag, "", "vault password for decrypting vault credentials")
-	rootCmd.PersistentFlags().Bool(consts.RawFlag, false, "show raw api response")
+	rootCmd.PersistentFlags().Bool(consts.RawFlag, false, "show raw api response(shortcut for '--output json')")
+	rootCmd.PersistentFlags().StringP(consts.OutputFlag, "o", printutils.FormatTable,
+		fmt.Sprintf("output format of the command result, one of: %s", strings.Join(printutils.OutputFormats, "|")))
 	rootCmd.PersistentFlags().Bool(consts.DebugFlag, false, "enable debug mode")
 	// subcommands
 	rootCmd.AddCommand(
-		NewGendocCmd(rootCmd),
 		ctx.NewCtxCmd(),
 		index.NewIndexCmd(),
+		alias.NewAliasCmd(),
 		stats.NewStatsCmd(),
 		ccr.NewCCRCmd(),
 		autofollow.NewAutofollowCmd(),
 		replication.NewReplicationCmd(),
+		rest.NewRestCmd(),
+		search.NewSearchCmd(),
+		doccmd.NewDocCmd(),
+		template.NewTemplateCmd(),
+		cluster.NewClusterCmd(),
+		snapshot.NewSnapshotCmd(),
+		ism.NewIsmCmd(),
+		security.NewSecurityCmd(),
+		tasks.NewTasksCmd(),
+		NewGendocCmd(rootCmd),
 	)
 }
 
@@ -80,7 +105,7 @@ func NewGendocCmd(rootCmd *cobra.Command) *cobra.Command {
 	var docDir string
 	var docCmd = &cobra.Command{
 		Use:     "gendoc",
-		Aliases: []string{"doc", "docs"},
+		Aliases: []string{"docs"},
 		Short:   "Generate Markdown documentation for the app",
 		Hidden:  true,
 		Run: func(cmd *cobra.Command, args []string) {
@@ -0,0 +1,120 @@
+package alias
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/aliases"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var log = logging.Logger()
+
+const (
+	ConfirmFlag       = "approve"
+	FilterFlag        = "filter"
+	RoutingFlag       = "routing"
+	IndexRoutingFlag  = "index-routing"
+	SearchRoutingFlag = "search-routing"
+	WriteIndexFlag    = "write-index"
+	FromFlag          = "from"
+)
+
+func NewAliasCmd() *cobra.Command {
+	// subcommands
+	aliasCmd.AddCommand(
+		aliasListCmd,
+		aliasAddCmd,
+		aliasRemoveCmd,
+		aliasSwapCmd,
+	)
+	return aliasCmd
+}
+
+// aliasCmd represents the alias command
+var aliasCmd = &cobra.Command{
+	Use:   "alias",
+	Short: "alias commands",
+	Long:  `Set of commands for index alias management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.HasAvailableSubCommands() {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		}
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{aliasAddCmd, aliasSwapCmd} {
+		c.Flags().String(FilterFlag, "", "query JSON limiting the documents visible through the alias.")
+		c.Flags().String(RoutingFlag, "", "routing value used for both indexing and search.")
+		c.Flags().String(IndexRoutingFlag, "", "routing value used for indexing.")
+		c.Flags().String(SearchRoutingFlag, "", "comma separated routing values used for search.")
+		c.Flags().Bool(WriteIndexFlag, false, "make the index the write index of the alias.")
+	}
+	for _, c := range []*cobra.Command{aliasAddCmd, aliasRemoveCmd, aliasSwapCmd} {
+		c.Flags().Bool(ConfirmFlag, false, "apply the changes without confirmation")
+	}
+	aliasSwapCmd.Flags().String(FromFlag, "", "index or pattern the alias is moved from, all its current indices by default.")
+}
+
+// options returns the alias options from the flags of the add and swap commands.
+func options(cmd *cobra.Command) aliases.Options {
+	opts := aliases.Options{
+		Routing:       flagutils.GetStringFlag(cmd.Flags(), RoutingFlag),
+		IndexRouting:  flagutils.GetStringFlag(cmd.Flags(), IndexRoutingFlag),
+		SearchRouting: flagutils.GetStringFlag(cmd.Flags(), SearchRoutingFlag),
+	}
+	if filter := flagutils.GetStringFlag(cmd.Flags(), FilterFlag); filter != "" {
+		if !json.Valid([]byte(filter)) {
+			log.Fatal().Msgf("flag '--%s' is not a valid JSON", FilterFlag)
+		}
+		opts.Filter = json.RawMessage(filter)
+	}
+	if cmd.Flags().Changed(WriteIndexFlag) {
+		writeIndex := flagutils.GetBoolFlag(cmd.Flags(), WriteIndexFlag)
+		opts.IsWriteIndex = &writeIndex
+	}
+	return opts
+}
+
+// resolveIndices expands the index expression to the existing indices or terminates the program.
+func resolveIndices(client *api.OpensearchWrapper, expression string) []string {
+	indices, err := client.ResolveIndices(expression)
+	if err != nil {
+		log.Fatal().Msgf("%v", err)
+	}
+	return indices
+}
+
+// apply logs the actions and applies them with the single request after the confirmation.
+func apply(cmd *cobra.Command, client *api.OpensearchWrapper, actions []aliases.Action) {
+	if len(actions) == 0 {
+		log.Warn().Msg("nothing to change")
+		return
+	}
+	plan := make([]string, 0, len(actions))
+	for _, action := range actions {
+		if action.Add != nil {
+			plan = append(plan, fmt.Sprintf("+ %s -> %s", action.Add.Alias, action.Add.Index))
+		} else {
+			plan = append(plan, fmt.Sprintf("- %s -> %s", action.Remove.Alias, action.Remove.Index))
+		}
+	}
+	log.Info().Msgf("alias actions:\n%s", strings.Join(plan, "\n"))
+	if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+		!prompts.IsOk(
+			prompts.QuestionPrompt(
+				fmt.Sprintf("[context:%s]Are you sure you want to apply these alias actions?", client.Config.Current))) {
+		return
+	}
+	if err := client.UpdateAliases(actions); err != nil {
+		log.Fatal().Msgf("failed to update aliases:%v", err)
+	}
+	log.Info().Msgf("%d alias actions applied", len(actions))
+}
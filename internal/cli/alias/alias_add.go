@@ -0,0 +1,30 @@
+package alias
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/aliases"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/spf13/cobra"
+)
+
+var aliasAddCmd = &cobra.Command{
+	Use:   "add <alias> <index|pattern>",
+	Short: "adds the alias to the indices.",
+	Long: fmt.Sprintf(`
+Add the alias to the index, the comma separated list of indices or the indices compliant with the pattern.
+The alias already pointing to the index is updated with the new filter, routing and write index options.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli alias add logs logs-v2
+opensearch-cli alias add logs-errors 'logs-*' --filter '{"term":{"level":"ERROR"}}'
+opensearch-cli alias add logs-write logs-v2 --write-index --approve
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := options(cmd)
+		client := api.NewFromCmd(cmd)
+		apply(cmd, client, aliases.AddActions(args[0], resolveIndices(client, args[1]), opts))
+	},
+}
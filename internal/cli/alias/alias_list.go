@@ -0,0 +1,39 @@
+package alias
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/aliases"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var aliasListCmd = &cobra.Command{
+	Use:     "list [alias|pattern]",
+	Aliases: []string{"ls"},
+	Short:   "lists aliases.",
+	Long: fmt.Sprintf(`
+List the aliases with their indices, only the aliases compliant with the pattern if it is set.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli alias list
+opensearch-cli alias list 'logs*' -o wide
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		list, err := api.NewFromCmd(cmd).GetAliases()
+		if err != nil {
+			log.Fatal().Msgf("failed to get aliases:%v", err)
+		}
+		if len(args) == 1 {
+			match := gu.GetMatchFunc(args[0])
+			list = fp.Filter(list, func(a aliases.Alias) bool {
+				return match(a.Alias)
+			})
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(list)
+	},
+}
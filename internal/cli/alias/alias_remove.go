@@ -0,0 +1,44 @@
+package alias
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/aliases"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/spf13/cobra"
+	"slices"
+)
+
+var aliasRemoveCmd = &cobra.Command{
+	Use:     "remove <alias> [index|pattern]",
+	Aliases: []string{"rm"},
+	Short:   "⚠️removes the alias from the indices.",
+	Long: fmt.Sprintf(`
+Remove the alias from the indices compliant with the pattern, or from all its indices if the pattern is not set.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli alias remove logs logs-v1
+opensearch-cli alias remove logs 'logs-2023*'
+opensearch-cli alias remove logs --approve
+`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewFromCmd(cmd)
+		list, err := client.GetAliases()
+		if err != nil {
+			log.Fatal().Msgf("failed to get aliases:%v", err)
+		}
+		indices := list.Indices(args[0])
+		if len(indices) == 0 {
+			log.Fatal().Msgf("alias '%s' not found", args[0])
+		}
+		if len(args) == 2 {
+			selected := resolveIndices(client, args[1])
+			indices = slices.DeleteFunc(indices, func(index string) bool {
+				return !slices.Contains(selected, index)
+			})
+		}
+		apply(cmd, client, aliases.RemoveActions(args[0], indices))
+	},
+}
@@ -0,0 +1,52 @@
+package alias
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/aliases"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/spf13/cobra"
+	"slices"
+)
+
+var aliasSwapCmd = &cobra.Command{
+	Use:   "swap <alias> <index|pattern>",
+	Short: "atomically moves the alias to the new indices.",
+	Long: fmt.Sprintf(`
+Move the alias to the index, the comma separated list of indices or the indices compliant with the pattern,
+removing it from its current indices compliant with '--from'(all its current indices by default).
+The removals and additions are applied by the single _aliases request, so the alias is never missing or doubled.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli alias swap logs logs-v2
+opensearch-cli alias swap logs logs-v2 --from logs-v1 --write-index --approve
+opensearch-cli alias swap logs-current 'logs-2024.06.*' --from 'logs-2024.05.*'
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := options(cmd)
+		client := api.NewFromCmd(cmd)
+		to := resolveIndices(client, args[1])
+		list, err := client.GetAliases()
+		if err != nil {
+			log.Fatal().Msgf("failed to get aliases:%v", err)
+		}
+		from := list.Indices(args[0])
+		if expression := flagutils.GetStringFlag(cmd.Flags(), FromFlag); expression != "" {
+			selected := resolveIndices(client, expression)
+			from = slices.DeleteFunc(from, func(index string) bool {
+				return !slices.Contains(selected, index)
+			})
+			if len(from) == 0 {
+				log.Fatal().Msgf("none of the indices of '--%s' holds alias '%s'", FromFlag, args[0])
+			}
+		}
+		if slices.Equal(from, to) && opts.IsEmpty() {
+			log.Info().Msgf("alias '%s' already points to %v", args[0], to)
+			return
+		}
+		apply(cmd, client, aliases.SwapActions(args[0], from, to, opts))
+	},
+}
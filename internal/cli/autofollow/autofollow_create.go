@@ -3,8 +3,8 @@ package autofollow
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
 	"github.com/dalet-oss/opensearch-cli/pkg/api/types/replication"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -23,10 +23,11 @@ var autofollowCreateCmd = &cobra.Command{
 			return
 		}
 		client := api.NewFromCmd(cmd)
-		if err := client.
-			CreateAutofollowRule(prepareAutofollowOpts(cmd.Flags(), args[0], client), flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
+		result, err := client.CreateAutofollowRule(prepareAutofollowOpts(cmd.Flags(), args[0], client))
+		if err != nil {
 			log.Fatal().Msgf("failed to create autofollow rule:%v", err)
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
 	},
 }
 
@@ -3,8 +3,8 @@ package autofollow
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
 	"github.com/dalet-oss/opensearch-cli/pkg/api/types/replication"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -20,11 +20,11 @@ var autofollowDeleteCmd = &cobra.Command{
 			}
 			return
 		}
-		client := api.NewFromCmd(cmd)
-		if err := client.
-			DeleteAutofollow(autofollowDeleteOpts(cmd.Flags(), args[0]), flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
+		result, err := api.NewFromCmd(cmd).DeleteAutofollow(autofollowDeleteOpts(cmd.Flags(), args[0]))
+		if err != nil {
 			log.Fatal().Msgf("failed to delete autofollow rule:%v", err)
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
 	},
 }
 
@@ -2,8 +2,7 @@ package autofollow
 
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/spf13/cobra"
 )
 
@@ -13,9 +12,10 @@ var autofollowListCmd = &cobra.Command{
 	Short:   "shows list of configured autofollow rules.",
 	Example: "opensearch-cli autofollow list",
 	Run: func(cmd *cobra.Command, args []string) {
-		if _, err := api.NewFromCmd(cmd).
-			ListOfAFRules(flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
+		rules, err := api.NewFromCmd(cmd).ListOfAFRules()
+		if err != nil {
 			log.Fatal().Msgf("failed to get the list autofollow rules:%v", err)
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(rules)
 	},
 }
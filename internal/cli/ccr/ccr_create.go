@@ -4,6 +4,7 @@ import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
 	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -20,10 +21,11 @@ var ccrCreateCmd = &cobra.Command{
 	Short:   "Create ccr object in the cluster",
 	Example: `opensearch-cli ccr create [--type=persistent] [--remote-mode=proxy] [--remote-name=pyramid-replication] [--remote-addr=<addr>]`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if err := api.NewFromCmd(cmd).
-			ConfigureRemoteCluster(prepareOpts(cmd.Flags()), flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
+		result, err := api.NewFromCmd(cmd).ConfigureRemoteCluster(prepareOpts(cmd.Flags()))
+		if err != nil {
 			log.Fatal().Msgf("failed to create remote cluster:%v", err)
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
 	},
 }
 
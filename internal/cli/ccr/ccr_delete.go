@@ -2,8 +2,7 @@ package ccr
 
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/spf13/cobra"
 )
 
@@ -16,9 +15,10 @@ var ccrDeleteCmd = &cobra.Command{
 		if len(args) == 0 || args[0] == "" {
 			log.Fatal().Msg("config name is required")
 		}
-		if err := api.NewFromCmd(cmd).
-			DeleteRemote(args[0], flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
+		result, err := api.NewFromCmd(cmd).DeleteRemote(args[0])
+		if err != nil {
 			log.Fatal().Msgf("failed to delete remote cluster:%v", err)
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
 	},
 }
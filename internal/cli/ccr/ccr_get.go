@@ -2,8 +2,7 @@ package ccr
 
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/spf13/cobra"
 )
 
@@ -12,9 +11,10 @@ var ccrGetCmd = &cobra.Command{
 	Short:   "query remote settings for the cluster",
 	Example: `opensearch-cli ccr get`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if err := api.NewFromCmd(cmd).
-			GetRemoteSettings(flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
+		settings, err := api.NewFromCmd(cmd).GetRemoteSettings()
+		if err != nil {
 			log.Fatal().Msgf("failed to get remote cluster settings:%v", err)
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(settings)
 	},
 }
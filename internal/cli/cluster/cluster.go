@@ -0,0 +1,47 @@
+package cluster
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/spf13/cobra"
+)
+
+var log = logging.Logger()
+
+const (
+	ConfirmFlag             = "approve"
+	WaitForStatusFlag       = "wait-for-status"
+	TimeoutFlag             = "timeout"
+	UnassignedFlag          = "unassigned"
+	IndexFlag               = "index"
+	ShardFlag               = "shard"
+	PrimaryFlag             = "primary"
+	IncludeYesDecisionsFlag = "include-yes-decisions"
+	TransientFlag           = "transient"
+)
+
+func NewClusterCmd() *cobra.Command {
+	// subcommands
+	clusterCmd.AddCommand(
+		clusterHealthCmd,
+		clusterNodesCmd,
+		clusterShardsCmd,
+		clusterAllocationExplainCmd,
+		clusterSettingsCmd,
+	)
+	clusterSettingsCmd.AddCommand(clusterSettingsGetCmd, clusterSettingsSetCmd)
+	return clusterCmd
+}
+
+// clusterCmd represents the cluster command
+var clusterCmd = &cobra.Command{
+	Use:   "cluster",
+	Short: "cluster commands",
+	Long:  `Set of commands for cluster monitoring and settings management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.HasAvailableSubCommands() {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		}
+	},
+}
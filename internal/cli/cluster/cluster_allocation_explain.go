@@ -0,0 +1,46 @@
+package cluster
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"github.com/spf13/cobra"
+)
+
+var clusterAllocationExplainCmd = &cobra.Command{
+	Use:   "allocation-explain",
+	Short: "explains the shard allocation.",
+	Long: `
+Explain why the shard copy is unassigned, or why it stays on its node, with the decision of every node.
+Without '--index' the first unassigned shard copy of the cluster is explained.
+The table shows the deciders which prevent the allocation, use '-o wide' to see all of them.
+`,
+	Example: `
+opensearch-cli cluster allocation-explain
+opensearch-cli cluster allocation-explain --index orders --shard 1 --primary=false -o wide
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		var shard *opensearchapi.ClusterAllocationExplainBody
+		if index := flagutils.GetStringFlag(cmd.Flags(), IndexFlag); index != "" {
+			shard = &opensearchapi.ClusterAllocationExplainBody{
+				Index:   index,
+				Shard:   flagutils.GetIntFlag(cmd.Flags(), ShardFlag),
+				Primary: flagutils.GetBoolFlag(cmd.Flags(), PrimaryFlag),
+			}
+		}
+		explanation, err := api.NewFromCmd(cmd).AllocationExplain(shard, flagutils.GetBoolFlag(cmd.Flags(), IncludeYesDecisionsFlag))
+		if err != nil {
+			log.Fatal().Msgf("failed to explain allocation:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(explanation)
+	},
+}
+
+func init() {
+	clusterAllocationExplainCmd.Flags().String(IndexFlag, "", "index of the shard to explain.")
+	clusterAllocationExplainCmd.Flags().Int(ShardFlag, 0, "number of the shard to explain.")
+	clusterAllocationExplainCmd.Flags().Bool(PrimaryFlag, true, "explain the primary copy of the shard, the replica otherwise.")
+	clusterAllocationExplainCmd.Flags().Bool(IncludeYesDecisionsFlag, false, "include the deciders which allow the allocation.")
+}
@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	tcluster "github.com/dalet-oss/opensearch-cli/pkg/api/types/cluster"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+	"strings"
+	"time"
+)
+
+var clusterHealthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "shows the cluster health.",
+	Long: `
+Show the health status of the cluster with the number of nodes and the shard allocation counters.
+With '--wait-for-status' the command waits up to '--timeout' for the status to become at least as good as the requested one
+and exits with code 1 if it doesn't, so it can be used in the scripts waiting for the cluster recovery.
+`,
+	Example: `
+opensearch-cli cluster health
+opensearch-cli cluster health --wait-for-status green --timeout 5m
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		status := ""
+		if cmd.Flags().Changed(WaitForStatusFlag) {
+			status = flagutils.GetStringFlagInSet(cmd.Flags(), WaitForStatusFlag, tcluster.HealthStatuses)
+		}
+		timeout, err := cmd.Flags().GetDuration(TimeoutFlag)
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		health, err := api.NewFromCmd(cmd).ClusterHealth(status, timeout)
+		if err != nil {
+			log.Fatal().Msgf("failed to get cluster health:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(health)
+		if health.TimedOut {
+			log.Fatal().Msgf("cluster status is '%s', '%s' is not reached in %s", health.Status, status, timeout)
+		}
+	},
+}
+
+func init() {
+	clusterHealthCmd.Flags().String(WaitForStatusFlag, "",
+		fmt.Sprintf("wait for the cluster status, one of: %s", strings.Join(tcluster.HealthStatuses, "|")))
+	clusterHealthCmd.Flags().Duration(TimeoutFlag, 30*time.Second, "maximal time to wait for the status.")
+}
@@ -0,0 +1,28 @@
+package cluster
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var clusterNodesCmd = &cobra.Command{
+	Use:   "nodes",
+	Short: "lists the cluster nodes.",
+	Long: `
+List the nodes of the cluster with their roles, heap, disk and CPU usage and version,
+the elected cluster manager is marked with '*'. Use '-o wide' to see the IP, uptime, memory, load and disk space.
+`,
+	Example: `
+opensearch-cli cluster nodes
+opensearch-cli cluster nodes -o wide
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		nodes, err := api.NewFromCmd(cmd).GetNodes()
+		if err != nil {
+			log.Fatal().Msgf("failed to get nodes:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(nodes)
+	},
+}
@@ -0,0 +1,81 @@
+package cluster
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	tcluster "github.com/dalet-oss/opensearch-cli/pkg/api/types/cluster"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var clusterSettingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "cluster settings commands",
+	Long:  `Set of commands for the cluster settings management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			log.Err(err).Msg("failed to show help")
+		}
+	},
+}
+
+var clusterSettingsGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "shows the cluster settings.",
+	Long:  `Show the persistent and transient settings of the cluster, use '-o json' to get them as nested objects.`,
+	Example: `
+opensearch-cli cluster settings get
+opensearch-cli cluster settings get -o json
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		settings, err := api.NewFromCmd(cmd).ClusterSettings()
+		if err != nil {
+			log.Fatal().Msgf("failed to get cluster settings:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(tcluster.Settings(settings))
+	},
+}
+
+var clusterSettingsSetCmd = &cobra.Command{
+	Use:   "set <key=value>...",
+	Short: "⚠️updates the cluster settings.",
+	Long: `
+Update the persistent cluster settings, or the transient ones with '--transient'.
+The empty value resets the setting to its default.
+`,
+	Example: `
+opensearch-cli cluster settings set cluster.routing.allocation.enable=primaries
+opensearch-cli cluster settings set cluster.routing.allocation.enable= --approve
+opensearch-cli cluster settings set indices.recovery.max_bytes_per_sec=200mb --transient
+`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		scope := fp.Ternary(tcluster.ScopeTransient, tcluster.ScopePersistent, flagutils.GetBoolFlag(cmd.Flags(), TransientFlag))
+		body, err := tcluster.SettingsBody(args, scope)
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		client := api.NewFromCmd(cmd)
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to update %s cluster settings %s?",
+						client.Config.Current, scope, strings.Join(args, ", ")))) {
+			return
+		}
+		if err := client.UpdateClusterSettings(body); err != nil {
+			log.Fatal().Msgf("failed to update cluster settings:%v", err)
+		}
+		log.Info().Msgf("%s cluster settings updated", scope)
+	},
+}
+
+func init() {
+	clusterSettingsSetCmd.Flags().Bool(TransientFlag, false, "update the transient settings, which are lost on the cluster restart.")
+	clusterSettingsSetCmd.Flags().Bool(ConfirmFlag, false, "update the settings without confirmation")
+}
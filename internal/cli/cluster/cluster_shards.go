@@ -0,0 +1,52 @@
+package cluster
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	tcluster "github.com/dalet-oss/opensearch-cli/pkg/api/types/cluster"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var clusterShardsCmd = &cobra.Command{
+	Use:   "shards [index|pattern]",
+	Short: "lists the shard allocation.",
+	Long: fmt.Sprintf(`
+List the shard copies of all indices, or of the indices compliant with the pattern, with their state and node.
+The unassigned copies are marked with ❌ and the initializing or relocating ones with ⚠️,
+'-o wide' shows why and for how long the copies are unassigned.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli cluster shards
+opensearch-cli cluster shards 'logs-*' -o wide
+opensearch-cli cluster shards --unassigned
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		expression := ""
+		if len(args) == 1 {
+			expression = args[0]
+		}
+		shards, err := api.NewFromCmd(cmd).GetShards(expression)
+		if err != nil {
+			log.Fatal().Msgf("failed to get shards:%v", err)
+		}
+		if flagutils.GetBoolFlag(cmd.Flags(), UnassignedFlag) {
+			shards = fp.Filter(shards, func(s tcluster.Shard) bool {
+				return s.State == tcluster.ShardUnassigned
+			})
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(shards)
+		if unassigned := shards.Count(tcluster.ShardUnassigned); unassigned > 0 {
+			log.Warn().Msgf("%d unassigned shard copies, use 'cluster allocation-explain' to find out why", unassigned)
+		}
+	},
+}
+
+func init() {
+	clusterShardsCmd.Flags().Bool(UnassignedFlag, false, "show the unassigned shard copies only.")
+}
@@ -12,7 +12,13 @@ func NewCtxCmd() *cobra.Command {
 	ctxCmd.AddCommand(
 		addCmd,
 		ctxCurrentCmd,
+		ctxDeleteCmd,
+		ctxDoctorCmd,
 		ctxListCmd,
+		ctxPruneCmd,
+		ctxRenameCmd,
+		ctxSetClusterCmd,
+		ctxSetUserCmd,
 		ctxSwitchCmd,
 		ctxViewCmd,
 	)
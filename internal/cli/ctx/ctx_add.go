@@ -1,12 +1,12 @@
 package ctx
 
 import (
+	"encoding/base64"
 	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/creds"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
 	"github.com/dalet-oss/opensearch-cli/pkg/ux/userconfig"
 	"github.com/spf13/cobra"
@@ -31,7 +31,7 @@ var addCmd = &cobra.Command{
 	Short:   "Creates a new context",
 	Long:    `Creates a new context in the config file using interactive mode`,
 	Run: func(cmd *cobra.Command, args []string) {
-		appConfigFile := flagutils.GetStringFlag(cmd.Flags(), consts.ConfigFlag)
+		appConfigFile := configutils.ConfigPath(cmd)
 		config := configutils.LoadConfig(appConfigFile)
 		newCluster := CreateClusterEntry(config)
 		user := userconfig.CreateUserEntry(config, newCluster)
@@ -41,7 +41,9 @@ var addCmd = &cobra.Command{
 			config.Current = ctx.Name
 		}
 		if !configutils.SaveConfig(appConfigFile, config) {
-			creds.DeleteFromKeyring(user.User.Token)
+			for _, id := range user.KeyringIds() {
+				creds.DeleteFromKeyring(id)
+			}
 		}
 	},
 }
@@ -77,6 +79,9 @@ func CreateClusterEntry(conf appconfig.AppConfig) appconfig.ClusterConfig {
 		v, _ := strconv.ParseBool(skipTLSVerify)
 		clusterConfig.Params.SkipTLSVerify = v
 	}
+	if strings.HasPrefix(clusterConfig.Params.Server, "https://") {
+		promptTLSParams(&clusterConfig.Params)
+	}
 
 	if len(clusterConfig.Name) == 0 {
 		clusterConfig.Name = strings.ReplaceAll(strings.ReplaceAll(clusterConfig.Params.Server, "://", "::"), ":", "::")
@@ -84,6 +89,60 @@ func CreateClusterEntry(conf appconfig.AppConfig) appconfig.ClusterConfig {
 	return clusterConfig
 }
 
+// promptTLSParams prompts for the optional CA, client certificate and server name of the TLS connection.
+// The certificate files are either referenced by path or embedded into the config as base64 encoded data.
+func promptTLSParams(params *appconfig.ClusterParams) {
+	if !params.SkipTLSVerify {
+		params.CertificateAuthority = prompts.ValidatedPrompt("(optional)CA certificate file", optionalFile)
+	}
+	params.ClientCertificate = prompts.ValidatedPrompt("(optional)Client certificate file", optionalFile)
+	if len(params.ClientCertificate) > 0 {
+		params.ClientKey = prompts.ValidatedPrompt("Client key file", func(input string) error {
+			if len(input) == 0 {
+				return fmt.Errorf("client key is required for the client certificate")
+			}
+			return optionalFile(input)
+		})
+	}
+	if !params.SkipTLSVerify {
+		params.TLSServerName = prompts.SimplePrompt("(optional)TLS server name")
+	}
+	if len(params.CertificateAuthority) == 0 && len(params.ClientCertificate) == 0 {
+		return
+	}
+	const referenceFiles = "reference"
+	const embedFiles = "embed"
+	if prompts.SelectivePrompt("How do you want to save certificates?", []string{referenceFiles, embedFiles}) == embedFiles {
+		params.CertificateAuthorityData, params.CertificateAuthority = embedFile(params.CertificateAuthority), ""
+		params.ClientCertificateData, params.ClientCertificate = embedFile(params.ClientCertificate), ""
+		params.ClientKeyData, params.ClientKey = embedFile(params.ClientKey), ""
+	}
+	if _, err := params.TLSConfig(); err != nil {
+		log.Fatal().Msgf("invalid TLS settings:%v", err)
+	}
+}
+
+// optionalFile validates that the input is either empty or the path to the readable file.
+func optionalFile(input string) error {
+	if len(input) == 0 {
+		return nil
+	}
+	_, err := gu.ReadFile(input)
+	return err
+}
+
+// embedFile returns the base64 encoded content of the file, empty string if the file is not set.
+func embedFile(file string) string {
+	if len(file) == 0 {
+		return ""
+	}
+	content, err := gu.ReadFile(file)
+	if err != nil {
+		log.Fatal().Msgf("unable to read file '%s':%v", file, err)
+	}
+	return base64.StdEncoding.EncodeToString(content)
+}
+
 // CreateContextEntry creates a new context entry
 func CreateContextEntry(conf appconfig.AppConfig, cluster appconfig.ClusterConfig, user appconfig.UserConfig) appconfig.ContextConfig {
 	newContext := appconfig.ContextConfig{}
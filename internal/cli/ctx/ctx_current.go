@@ -2,7 +2,6 @@ package ctx
 
 import (
 	"fmt"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
 	"github.com/spf13/cobra"
 )
@@ -12,8 +11,7 @@ var ctxCurrentCmd = &cobra.Command{
 	Use:   "show",
 	Short: "show active context information.",
 	Run: func(cmd *cobra.Command, args []string) {
-		appConfigFile, _ := cmd.Flags().GetString(consts.ConfigFlag)
-		config := configutils.LoadConfig(appConfigFile)
+		config := configutils.LoadConfigForCmd(cmd)
 		fmt.Println(config.ShowContextInfo(config.Current))
 		fmt.Println()
 		fmt.Println("Legend:\n\t✅ - entry found in the configuration file.\n\t❌ - entry not found in the configuration file. ")
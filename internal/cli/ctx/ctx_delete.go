@@ -0,0 +1,52 @@
+package ctx
+
+import (
+	"fmt"
+	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+)
+
+const ConfirmFlag = "approve"
+
+// ctxDeleteCmd represents the delete command
+var ctxDeleteCmd = &cobra.Command{
+	Use:     "delete",
+	Aliases: []string{"rm"},
+	Short:   "deletes a context.",
+	Long: `
+Delete the context from the config file.
+If context name is not provided, it will prompt for the context name(from the list of contexts).
+The cluster and user of the context are kept, use 'ctx prune' to remove the ones which are not used anymore.
+`,
+	Example: `opensearch-cli ctx delete [CONTEXT NAME] [--approve]`,
+	Run: func(cmd *cobra.Command, args []string) {
+		appConfigFile := configutils.ConfigPath(cmd)
+		config := configutils.LoadConfig(appConfigFile)
+		name := ""
+		if len(args) > 0 {
+			name = args[0]
+		} else {
+			name = prompts.SelectivePrompt("Select context for removal", config.GetContextList())
+		}
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(prompts.QuestionPrompt(fmt.Sprintf("Are you sure you want to delete context '%s'?", name))) {
+			return
+		}
+		if err := config.DeleteContext(name); err != nil {
+			log.Fatal().Msgf("unable to delete context:%v", err)
+		}
+		if !configutils.SaveConfig(appConfigFile, config) {
+			log.Fatal().Msg("❌Failed to save config file. Please try again.")
+		}
+		log.Info().Msgf("context '%s' deleted", name)
+		if config.Current == "" {
+			log.Warn().Msg("the deleted context was the current one, use 'ctx switch' to select another context")
+		}
+	},
+}
+
+func init() {
+	ctxDeleteCmd.Flags().Bool(ConfirmFlag, false, "delete without confirmation.")
+}
@@ -0,0 +1,37 @@
+package ctx
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+// ctxDoctorCmd represents the doctor command
+var ctxDoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "validates the config and diagnoses the connectivity of the context.",
+	Long: `
+Validate the config file(api version, duplicate names, dangling references, vault files and keyring entries)
+and probe the cluster of the context: DNS, TCP, TLS handshake of every server address, then the authentication,
+the info request and the list of installed plugins.
+If context name is not provided, the active context is used. Exits with non-zero code if any check failed.
+`,
+	Example: `opensearch-cli ctx doctor [CONTEXT NAME]`,
+	Args:    cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		config := configutils.LoadConfigForCmd(cmd)
+		name := config.Current
+		if len(args) > 0 {
+			name = args[0]
+		}
+		if name == "" {
+			log.Fatal().Msg("no context is selected, provide the context name or use 'ctx switch'")
+		}
+		report := api.Diagnose(config, configutils.CreateApiContext(cmd), name)
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(report)
+		if failed := report.Failed(); failed > 0 {
+			log.Fatal().Msgf("❌%d of %d checks failed", failed, len(report.Checks))
+		}
+	},
+}
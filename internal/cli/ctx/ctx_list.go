@@ -2,7 +2,6 @@ package ctx
 
 import (
 	"fmt"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
 	"github.com/spf13/cobra"
 )
@@ -13,7 +12,7 @@ var ctxListCmd = &cobra.Command{
 	Short: "list all contexts.",
 	Long:  `Show information about all contexts.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		appConfigFile, _ := cmd.Flags().GetString(consts.ConfigFlag)
+		appConfigFile := configutils.ConfigPath(cmd)
 		config := configutils.LoadConfig(appConfigFile)
 		config.ListContexts()
 		fmt.Println()
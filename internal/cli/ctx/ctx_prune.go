@@ -0,0 +1,61 @@
+package ctx
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
+	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/creds"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"slices"
+	"strings"
+)
+
+// ctxPruneCmd represents the prune command
+var ctxPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "removes unused clusters and users.",
+	Long: `
+Remove the clusters and users which are not referenced by any context,
+the keyring entries of the removed users are deleted as well.
+`,
+	Example: `opensearch-cli ctx prune [--approve]`,
+	Run: func(cmd *cobra.Command, args []string) {
+		appConfigFile := configutils.ConfigPath(cmd)
+		config := configutils.LoadConfig(appConfigFile)
+		pruned := config.Prune()
+		if pruned.IsEmpty() {
+			log.Info().Msg("nothing to prune, all clusters and users are used by contexts")
+			return
+		}
+		userNames := fp.Map(pruned.Users, func(u appconfig.UserConfig) string { return u.Name })
+		log.Info().Msgf("unused clusters: [%s]", strings.Join(pruned.Clusters, ", "))
+		log.Info().Msgf("unused users: [%s]", strings.Join(userNames, ", "))
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(prompts.QuestionPrompt(fmt.Sprintf("Are you sure you want to remove %d clusters and %d users?", len(pruned.Clusters), len(pruned.Users)))) {
+			return
+		}
+		if !configutils.SaveConfig(appConfigFile, config) {
+			log.Fatal().Msg("❌Failed to save config file. Please try again.")
+		}
+		// the keyring entries are removed only after the config is saved, so the config never references missing entries
+		usedIds := []string{}
+		for _, user := range config.Users {
+			usedIds = append(usedIds, user.KeyringIds()...)
+		}
+		for _, user := range pruned.Users {
+			for _, id := range user.KeyringIds() {
+				if !slices.Contains(usedIds, id) {
+					creds.DeleteFromKeyring(id)
+				}
+			}
+		}
+		log.Info().Msgf("removed %d clusters and %d users", len(pruned.Clusters), len(pruned.Users))
+	},
+}
+
+func init() {
+	ctxPruneCmd.Flags().Bool(ConfirmFlag, false, "prune without confirmation.")
+}
@@ -0,0 +1,26 @@
+package ctx
+
+import (
+	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
+	"github.com/spf13/cobra"
+)
+
+// ctxRenameCmd represents the rename command
+var ctxRenameCmd = &cobra.Command{
+	Use:     "rename",
+	Short:   "renames a context.",
+	Long:    `Rename the context in the config file, the current context follows the renamed one.`,
+	Example: `opensearch-cli ctx rename <OLD NAME> <NEW NAME>`,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		appConfigFile := configutils.ConfigPath(cmd)
+		config := configutils.LoadConfig(appConfigFile)
+		if err := config.RenameContext(args[0], args[1]); err != nil {
+			log.Fatal().Msgf("unable to rename context:%v", err)
+		}
+		if !configutils.SaveConfig(appConfigFile, config) {
+			log.Fatal().Msg("❌Failed to save config file. Please try again.")
+		}
+		log.Info().Msgf("context '%s' renamed to '%s'", args[0], args[1])
+	},
+}
@@ -0,0 +1,48 @@
+package ctx
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
+	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+)
+
+// ctxSetClusterCmd represents the set-cluster command
+var ctxSetClusterCmd = &cobra.Command{
+	Use:   "set-cluster",
+	Short: "points a context to another cluster.",
+	Long: `
+Point the context to another cluster defined in the config file.
+If context or cluster name is not provided, it will prompt for it.
+`,
+	Example: `opensearch-cli ctx set-cluster [CONTEXT NAME] [CLUSTER NAME]`,
+	Args:    cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		appConfigFile := configutils.ConfigPath(cmd)
+		config := configutils.LoadConfig(appConfigFile)
+		name, cluster := contextArg(config, args), ""
+		if len(args) > 1 {
+			cluster = args[1]
+		} else {
+			cluster = prompts.SelectivePrompt("Select cluster", fp.Map(config.Clusters, func(c appconfig.ClusterConfig) string {
+				return c.Name
+			}))
+		}
+		if err := config.SetContextCluster(name, cluster); err != nil {
+			log.Fatal().Msgf("unable to set cluster:%v", err)
+		}
+		if !configutils.SaveConfig(appConfigFile, config) {
+			log.Fatal().Msg("❌Failed to save config file. Please try again.")
+		}
+		log.Info().Msgf("context '%s' points to cluster '%s'", name, cluster)
+	},
+}
+
+// contextArg returns the context name from the first argument or prompts for it.
+func contextArg(config appconfig.AppConfig, args []string) string {
+	if len(args) > 0 {
+		return args[0]
+	}
+	return prompts.SelectivePrompt("Select context", config.GetContextList())
+}
@@ -0,0 +1,40 @@
+package ctx
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
+	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+)
+
+// ctxSetUserCmd represents the set-user command
+var ctxSetUserCmd = &cobra.Command{
+	Use:   "set-user",
+	Short: "points a context to another user.",
+	Long: `
+Point the context to another user defined in the config file.
+If context or user name is not provided, it will prompt for it.
+`,
+	Example: `opensearch-cli ctx set-user [CONTEXT NAME] [USER NAME]`,
+	Args:    cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		appConfigFile := configutils.ConfigPath(cmd)
+		config := configutils.LoadConfig(appConfigFile)
+		name, user := contextArg(config, args), ""
+		if len(args) > 1 {
+			user = args[1]
+		} else {
+			user = prompts.SelectivePrompt("Select user", fp.Map(config.Users, func(u appconfig.UserConfig) string {
+				return u.Name
+			}))
+		}
+		if err := config.SetContextUser(name, user); err != nil {
+			log.Fatal().Msgf("unable to set user:%v", err)
+		}
+		if !configutils.SaveConfig(appConfigFile, config) {
+			log.Fatal().Msg("❌Failed to save config file. Please try again.")
+		}
+		log.Info().Msgf("context '%s' points to user '%s'", name, user)
+	},
+}
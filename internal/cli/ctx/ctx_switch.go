@@ -3,7 +3,6 @@ package ctx
 import (
 	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
 	"github.com/spf13/cobra"
@@ -18,7 +17,7 @@ Switch to active context to the one chosen by the user.
 If context name is not provided, it will prompt for the context name(from the list of contexts).
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		appConfigFile, _ := cmd.Flags().GetString(consts.ConfigFlag)
+		appConfigFile := configutils.ConfigPath(cmd)
 		config := configutils.LoadConfig(appConfigFile)
 		if len(args) > 0 {
 			if config.HasContext(appconfig.ContextConfig{Name: args[0]}) {
@@ -1,26 +1,51 @@
 package ctx
 
 import (
+	"context"
 	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
 	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
 	"github.com/spf13/cobra"
 )
 
+const OfflineFlag = "offline"
+
 // ctxViewCmd represents the view command
 var ctxViewCmd = &cobra.Command{
 	Use:   "view",
 	Short: "show active context information.",
-	Long:  `Show entire information about the active context(except the credentials)`,
+	Long: `Show entire information about the active context(except the credentials)
+and the cluster node which answered the info request, use --offline to skip the connection.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		appConfigFile, _ := cmd.Flags().GetString(consts.ConfigFlag)
-		config := configutils.LoadConfig(appConfigFile)
+		config := configutils.LoadConfigForCmd(cmd)
 		var info string
 		if len(args) > 0 {
 			info = config.ShowContextInfoExtended(args[0])
+			config.Current = args[0]
 		} else {
 			info = config.ShowContextInfoExtended(config.Current)
 		}
 		fmt.Println(info)
+		if flagutils.GetBoolFlag(cmd.Flags(), OfflineFlag) || config.GetActiveContext() == nil {
+			return
+		}
+		// the context from the args takes precedence over the context override
+		apiCtx := context.WithValue(configutils.CreateApiContext(cmd), consts.ContextFlag, config.Current)
+		client, err := api.New(config, apiCtx)
+		if err != nil {
+			fmt.Printf("	❌Unable to connect: %v\n", err)
+			return
+		}
+		if node, nodeErr := client.AnsweringNode(); nodeErr != nil {
+			fmt.Printf("	❌Unable to connect: %v\n", nodeErr)
+		} else {
+			fmt.Printf("	Answered by: %s(%s), cluster %s, version %s\n", node.Name, node.Address, node.ClusterName, node.Version)
+		}
 	},
 }
+
+func init() {
+	ctxViewCmd.Flags().Bool(OfflineFlag, false, "don't connect to the cluster.")
+}
@@ -0,0 +1,85 @@
+package doc
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/document"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var log = logging.Logger()
+
+const (
+	RoutingFlag       = "routing"
+	RefreshFlag       = "refresh"
+	IfSeqNoFlag       = "if-seq-no"
+	IfPrimaryTermFlag = "if-primary-term"
+	DataFlag          = "data"
+	DataFileFlag      = "data-file"
+	ConfirmFlag       = "approve"
+)
+
+func NewDocCmd() *cobra.Command {
+	// subcommands
+	docCmd.AddCommand(
+		docGetCmd,
+		docPutCmd,
+		docUpdateCmd,
+		docDeleteCmd,
+		docExistsCmd,
+		docImportCmd,
+		docExportCmd,
+	)
+	return docCmd
+}
+
+// docCmd represents the doc command
+var docCmd = &cobra.Command{
+	Use:   "doc",
+	Short: "document commands",
+	Long:  `Set of commands for the single document management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.HasAvailableSubCommands() {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		}
+	},
+}
+
+// addOptionFlags adds the routing and refresh flags, the write commands get the optimistic concurrency flags too.
+func addOptionFlags(cmd *cobra.Command, write bool) {
+	cmd.Flags().String(RoutingFlag, "", "custom routing value of the document.")
+	cmd.Flags().String(RefreshFlag, "",
+		fmt.Sprintf("refresh the affected shards, one of: %s", strings.Join(document.RefreshModes, "|")))
+	if write {
+		cmd.Flags().Int(IfSeqNoFlag, 0, "fail unless the document has this sequence number, requires --if-primary-term.")
+		cmd.Flags().Int(IfPrimaryTermFlag, 0, "fail unless the document has this primary term, requires --if-seq-no.")
+		cmd.MarkFlagsRequiredTogether(IfSeqNoFlag, IfPrimaryTermFlag)
+	}
+}
+
+// options returns the document options from the flags added by addOptionFlags.
+func options(cmd *cobra.Command) document.Options {
+	opts := document.Options{Routing: flagutils.GetStringFlag(cmd.Flags(), RoutingFlag)}
+	if cmd.Flags().Changed(RefreshFlag) {
+		opts.Refresh = flagutils.GetStringFlagInSet(cmd.Flags(), RefreshFlag, document.RefreshModes)
+	}
+	if cmd.Flags().Changed(IfSeqNoFlag) {
+		seqNo, primaryTerm := flagutils.GetIntFlag(cmd.Flags(), IfSeqNoFlag), flagutils.GetIntFlag(cmd.Flags(), IfPrimaryTermFlag)
+		opts.IfSeqNo, opts.IfPrimaryTerm = &seqNo, &primaryTerm
+	}
+	if err := opts.Validate(); err != nil {
+		log.Fatal().Msgf("%v", err)
+	}
+	return opts
+}
+
+// addDataFlags adds the flags of the request body.
+func addDataFlags(cmd *cobra.Command, usage string) {
+	cmd.Flags().StringP(DataFlag, "d", "", usage)
+	cmd.Flags().StringP(DataFileFlag, "f", "", "file with the "+strings.TrimSuffix(usage, ".")+", '-' reads stdin.")
+	cmd.MarkFlagsMutuallyExclusive(DataFlag, DataFileFlag)
+}
@@ -0,0 +1,43 @@
+package doc
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+)
+
+var docDeleteCmd = &cobra.Command{
+	Use:   "delete <index> <id>",
+	Short: "⚠️deletes the document.",
+	Long:  `Delete the document of the index by its id, asks for the confirmation unless '--approve' is set.`,
+	Example: `
+opensearch-cli doc delete orders 42
+opensearch-cli doc delete orders 42 --approve --if-seq-no 10 --if-primary-term 1
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := options(cmd)
+		client := api.NewFromCmd(cmd)
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf(
+						"[context:%s]Are you sure you want to delete document '%s' of index '%s'?", client.Config.Current,
+						args[1], args[0]))) {
+			return
+		}
+		result, err := client.DeleteDocument(args[0], args[1], opts)
+		if err != nil {
+			log.Fatal().Msgf("failed to delete document:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
+	},
+}
+
+func init() {
+	addOptionFlags(docDeleteCmd, true)
+	docDeleteCmd.Flags().Bool(ConfirmFlag, false, "delete document without confirmation")
+}
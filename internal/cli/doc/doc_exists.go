@@ -0,0 +1,34 @@
+package doc
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+var docExistsCmd = &cobra.Command{
+	Use:   "exists <index> <id>",
+	Short: "checks if the document exists.",
+	Long:  `Check if the document of the index exists, the command exits with code 1 if it doesn't.`,
+	Example: `
+opensearch-cli doc exists orders 42 && echo found
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := options(cmd)
+		client := api.NewFromCmd(cmd)
+		exists, err := client.DocumentExists(args[0], args[1], opts)
+		if err != nil {
+			log.Fatal().Msgf("failed to check document:%v", err)
+		}
+		if !exists {
+			log.Info().Msgf("document '%s' is not found in '%s'", args[1], args[0])
+			os.Exit(1)
+		}
+		log.Info().Msgf("document '%s' exists in '%s'", args[1], args[0])
+	},
+}
+
+func init() {
+	addOptionFlags(docExistsCmd, false)
+}
@@ -0,0 +1,118 @@
+package doc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/search"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	GzipFlag     = "gzip"
+	QueryFlag    = "query"
+	SortFlag     = "sort"
+	FieldsFlag   = "fields"
+	PageSizeFlag = "page-size"
+)
+
+var docExportCmd = &cobra.Command{
+	Use:   "export <index|pattern>",
+	Short: "exports the documents as NDJSON.",
+	Long: fmt.Sprintf(`
+Export every document of the index, the comma separated list of indices or the indices compliant with the pattern
+to the file given by '--data-file'(stdout by default) as NDJSON, one line with _index, _id, _routing and _source per document.
+The documents are streamed with search_after on the point in time sorted by '--sort'(_index,_id by default),
+the sort fields together must identify the document uniquely across the exported indices.
+The output is gzip-compressed with '--gzip' or when the file name ends with '.gz', the result is accepted by 'doc import'.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli doc export orders > orders.ndjson
+opensearch-cli doc export 'logs-2024.*' -f logs.ndjson.gz -q 'level:ERROR'
+opensearch-cli doc export orders --sort created:asc,order_id --fields status,total --gzip > orders.ndjson.gz
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := search.Options{
+			Query:    flagutils.GetStringFlag(cmd.Flags(), QueryFlag),
+			Sort:     flagutils.GetStringSliceFlag(cmd.Flags(), SortFlag),
+			Fields:   flagutils.GetStringSliceFlag(cmd.Flags(), FieldsFlag),
+			PageSize: flagutils.GetIntFlag(cmd.Flags(), PageSizeFlag),
+		}
+		file := flagutils.GetNotEmptyStringFlag(cmd.Flags(), DataFileFlag)
+		client := api.NewFromCmd(cmd)
+		indices, err := client.ResolveIndices(args[0])
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		output, err := openOutput(cmd, file)
+		if err != nil {
+			log.Fatal().Msgf("unable to open output:%v", err)
+		}
+		var compressor *gzip.Writer
+		writer := bufio.NewWriter(output)
+		var documents io.Writer = writer
+		if flagutils.GetBoolFlag(cmd.Flags(), GzipFlag) || strings.HasSuffix(file, ".gz") {
+			compressor = gzip.NewWriter(writer)
+			documents = compressor
+		}
+
+		progress := printutils.NewProgress("export", 0)
+		exported := 0
+		summary, exportErr := client.Export(indices, opts, documents, func(n int) {
+			exported = n
+			progress.Update(int64(n), fmt.Sprintf("%d exported", n))
+		})
+		progress.Finish(int64(exported), fmt.Sprintf("%d exported", exported))
+		if compressor != nil {
+			err = compressor.Close()
+		}
+		if flushErr := writer.Flush(); err == nil {
+			err = flushErr
+		}
+		if closeErr := output.Close(); err == nil {
+			err = closeErr
+		}
+		if exportErr != nil {
+			log.Fatal().Msgf("export failed after %d documents:%v", exported, exportErr)
+		} else if err != nil {
+			log.Fatal().Msgf("unable to write output:%v", err)
+		}
+		log.Info().Msgf("%d documents exported from %s", summary.Returned, strings.Join(indices, ","))
+	},
+}
+
+func init() {
+	docExportCmd.Flags().StringP(DataFileFlag, "f", "-", "output file, '-' writes to stdout.")
+	docExportCmd.Flags().Bool(GzipFlag, false, "gzip-compress the output.")
+	docExportCmd.Flags().StringP(QueryFlag, "q", "", "Lucene query string selecting the exported documents.")
+	docExportCmd.Flags().StringSlice(SortFlag, api.DefaultExportSort,
+		"comma separated list of the sort fields in the form field[:asc|desc], together they must identify the document uniquely.")
+	docExportCmd.Flags().StringSlice(FieldsFlag, nil, "comma separated list of the exported source fields.")
+	docExportCmd.Flags().Int(PageSizeFlag, search.DefaultPageSize, "number of documents fetched by the single request.")
+}
+
+// openOutput creates the file, '-' returns stdout which is not closed.
+func openOutput(cmd *cobra.Command, file string) (io.WriteCloser, error) {
+	if file == "-" {
+		return nopWriteCloser{cmd.OutOrStdout()}, nil
+	}
+	return os.Create(file)
+}
+
+// nopWriteCloser wraps the writer which must not be closed.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
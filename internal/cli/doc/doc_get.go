@@ -0,0 +1,45 @@
+package doc
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+const SourceOnlyFlag = "source-only"
+
+var docGetCmd = &cobra.Command{
+	Use:   "get <index> <id>",
+	Short: "returns the document.",
+	Long: `
+Return the document of the index by its id with its version, sequence number and primary term,
+the latter two are used with '--if-seq-no' and '--if-primary-term' of the write commands.
+Use '--source-only' to print only the document source as JSON.
+`,
+	Example: `
+opensearch-cli doc get orders 42
+opensearch-cli doc get orders 42 --routing customer-7 -o json
+opensearch-cli doc get orders 42 --source-only | jq .status
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := options(cmd)
+		client := api.NewFromCmd(cmd)
+		doc, err := client.GetDocument(args[0], args[1], opts)
+		if err != nil {
+			log.Fatal().Msgf("failed to get document:%v", err)
+		}
+		if flagutils.GetBoolFlag(cmd.Flags(), SourceOnlyFlag) {
+			fmt.Println(string(doc.Source))
+			return
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(doc)
+	},
+}
+
+func init() {
+	addOptionFlags(docGetCmd, false)
+	docGetCmd.Flags().Bool(SourceOnlyFlag, false, "print only the document source.")
+}
@@ -0,0 +1,138 @@
+package doc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/bulk"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/document"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	BatchSizeFlag   = "batch-size"
+	ConcurrencyFlag = "concurrency"
+	MaxRetriesFlag  = "max-retries"
+)
+
+var docImportCmd = &cobra.Command{
+	Use:   "import <index>",
+	Short: "imports NDJSON documents with the _bulk API.",
+	Long: `
+Import the documents from the NDJSON file given by '--data-file'('-' reads stdin), gzip-compressed files are detected automatically.
+Every line is either the document source, indexed with the generated id,
+or the hit written by 'doc export' or 'search -o ndjson', whose _id and _routing are kept.
+The documents are sent in batches by the parallel requests, the documents rejected with 429 are retried with the backoff.
+The failed documents are printed with their reasons and the command exits with non-zero code if any failed.
+`,
+	Example: `
+opensearch-cli doc import orders -f orders.ndjson
+opensearch-cli doc import orders -f orders.ndjson.gz --batch-size 2000 --concurrency 4
+opensearch-cli --context staging doc export orders | opensearch-cli --context dev doc import orders -f -
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := bulk.Options{
+			BatchSize:   flagutils.GetIntFlag(cmd.Flags(), BatchSizeFlag),
+			Concurrency: flagutils.GetIntFlag(cmd.Flags(), ConcurrencyFlag),
+			MaxRetries:  flagutils.GetIntFlag(cmd.Flags(), MaxRetriesFlag),
+		}
+		if cmd.Flags().Changed(RefreshFlag) {
+			opts.Refresh = flagutils.GetStringFlagInSet(cmd.Flags(), RefreshFlag, document.RefreshModes)
+		}
+		input, size, err := openInput(cmd, flagutils.GetNotEmptyStringFlag(cmd.Flags(), DataFileFlag))
+		if err != nil {
+			log.Fatal().Msgf("unable to open input:%v", err)
+		}
+		defer input.Close()
+		counter := &countingReader{r: input}
+		documents, err := decompress(counter)
+		if err != nil {
+			log.Fatal().Msgf("unable to read input:%v", err)
+		}
+
+		client := api.NewFromCmd(cmd)
+		progress := printutils.NewProgress("import", size)
+		summary, err := client.Import(args[0], documents, opts, func(s bulk.Summary) {
+			progress.Update(counter.n.Load(), progressDetails(s))
+		})
+		progress.Finish(counter.n.Load(), progressDetails(summary))
+		if err != nil {
+			log.Fatal().Msgf("import aborted, unable to read input:%v", err)
+		}
+		printer := printutils.FromFlags(cmd.Flags())
+		if printer.IsStructured() {
+			printer.PrintOrDie(summary)
+		} else if len(summary.Failures) > 0 {
+			printer.PrintOrDie(summary.Failures)
+		}
+		if len(summary.Failures) > 0 {
+			log.Fatal().Msgf("%s into '%s'", summary, args[0])
+		}
+		log.Info().Msgf("%s into '%s'", summary, args[0])
+	},
+}
+
+func init() {
+	docImportCmd.Flags().StringP(DataFileFlag, "f", "", "NDJSON file with the documents, '-' reads stdin.")
+	docImportCmd.Flags().Int(BatchSizeFlag, bulk.DefaultBatchSize, "number of documents sent by the single _bulk request.")
+	docImportCmd.Flags().Int(ConcurrencyFlag, bulk.DefaultConcurrency, "number of _bulk requests sent in parallel.")
+	docImportCmd.Flags().Int(MaxRetriesFlag, bulk.DefaultMaxRetries, "number of retries of the documents rejected with 429.")
+	docImportCmd.Flags().String(RefreshFlag, "",
+		fmt.Sprintf("refresh the affected shards after every request, one of: %s", strings.Join(document.RefreshModes, "|")))
+	_ = docImportCmd.MarkFlagRequired(DataFileFlag)
+}
+
+// progressDetails returns the counters of the import shown next to the progress bar.
+func progressDetails(s bulk.Summary) string {
+	return fmt.Sprintf("%d imported, %d failed", s.Indexed, len(s.Failures))
+}
+
+// openInput opens the file, '-' opens stdin, returns the file size or 0 if it is unknown.
+func openInput(cmd *cobra.Command, file string) (io.ReadCloser, int64, error) {
+	if file == "-" {
+		return io.NopCloser(cmd.InOrStdin()), 0, nil
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, 0, err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, 0, err
+	}
+	return f, stat.Size(), nil
+}
+
+// decompress returns the reader of the decompressed content if the input is gzip-compressed, otherwise the input as is.
+func decompress(r io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(r)
+	magic, err := buffered.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		return gzip.NewReader(buffered)
+	}
+	return buffered, nil
+}
+
+// countingReader counts the bytes read from the underlying reader, the count is safe to read concurrently.
+type countingReader struct {
+	r io.Reader
+	n atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,55 @@
+package doc
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+const CreateFlag = "create"
+
+var docPutCmd = &cobra.Command{
+	Use:   "put <index> [id]",
+	Short: "indexes the document.",
+	Long: `
+Index the document read from '--data', or from the file given by '--data-file'('-' reads stdin).
+The existing document with the same id is replaced unless '--create' is set, the id is generated when omitted.
+`,
+	Example: `
+opensearch-cli doc put orders 42 -d '{"status":"new"}'
+opensearch-cli doc put orders 42 --data-file order.json --create --refresh wait_for
+cat order.json | opensearch-cli doc put orders -f -
+opensearch-cli doc put orders 42 -f order.json --if-seq-no 10 --if-primary-term 1
+`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := options(cmd)
+		id := ""
+		if len(args) == 2 {
+			id = args[1]
+		}
+		body, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal().Msgf("unable to read document:%v", err)
+		} else if body == nil {
+			log.Fatal().Msgf("document is required, use '--%s' or '--%s'", DataFlag, DataFileFlag)
+		} else if !json.Valid(body) {
+			log.Fatal().Msg("document is not a valid JSON")
+		}
+		client := api.NewFromCmd(cmd)
+		result, err := client.PutDocument(args[0], id, bytes.NewReader(body), flagutils.GetBoolFlag(cmd.Flags(), CreateFlag), opts)
+		if err != nil {
+			log.Fatal().Msgf("failed to index document:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
+	},
+}
+
+func init() {
+	addOptionFlags(docPutCmd, true)
+	addDataFlags(docPutCmd, "document source.")
+	docPutCmd.Flags().Bool(CreateFlag, false, "fail if the document already exists.")
+}
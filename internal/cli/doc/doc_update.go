@@ -0,0 +1,73 @@
+package doc
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/document"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ScriptFlag       = "script"
+	ScriptLangFlag   = "lang"
+	ScriptParamsFlag = "params"
+	UpsertFlag       = "upsert"
+)
+
+var docUpdateCmd = &cobra.Command{
+	Use:   "update <index> <id>",
+	Short: "partially updates the document.",
+	Long: `
+Update the document with the partial document read from '--data' or '--data-file'('-' reads stdin),
+or with the script('--script' with the JSON '--params').
+'--upsert' creates the document when it is missing: from the partial document, or by running the script on the empty one.
+`,
+	Example: `
+opensearch-cli doc update orders 42 -d '{"status":"shipped"}'
+opensearch-cli doc update orders 42 --script 'ctx._source.retries += params.n' --params '{"n":1}'
+opensearch-cli doc update orders 42 -d '{"status":"shipped"}' --if-seq-no 10 --if-primary-term 1
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := options(cmd)
+		doc, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal().Msgf("unable to read partial document:%v", err)
+		}
+		var params map[string]any
+		if p := flagutils.GetStringFlag(cmd.Flags(), ScriptParamsFlag); p != "" {
+			if err := json.Unmarshal([]byte(p), &params); err != nil {
+				log.Fatal().Msgf("script params are not a valid JSON object:%v", err)
+			}
+		}
+		body, err := document.UpdateBody(
+			doc,
+			flagutils.GetStringFlag(cmd.Flags(), ScriptFlag),
+			flagutils.GetStringFlag(cmd.Flags(), ScriptLangFlag),
+			params,
+			flagutils.GetBoolFlag(cmd.Flags(), UpsertFlag))
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		client := api.NewFromCmd(cmd)
+		result, err := client.UpdateDocument(args[0], args[1], bytes.NewReader(body), opts)
+		if err != nil {
+			log.Fatal().Msgf("failed to update document:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
+	},
+}
+
+func init() {
+	addOptionFlags(docUpdateCmd, true)
+	addDataFlags(docUpdateCmd, "partial document.")
+	docUpdateCmd.Flags().String(ScriptFlag, "", "source of the update script.")
+	docUpdateCmd.Flags().String(ScriptLangFlag, "", "language of the update script(default painless).")
+	docUpdateCmd.Flags().String(ScriptParamsFlag, "", "JSON object with the script params.")
+	docUpdateCmd.Flags().Bool(UpsertFlag, false, "create the document if it is missing.")
+	docUpdateCmd.MarkFlagsMutuallyExclusive(DataFlag, ScriptFlag)
+	docUpdateCmd.MarkFlagsMutuallyExclusive(DataFileFlag, ScriptFlag)
+}
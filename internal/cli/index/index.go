@@ -5,13 +5,48 @@ import "github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
 
 var log = logging.Logger()
 
+const (
+	DataFlag            = "data"
+	DataFileFlag        = "data-file"
+	SetFlag             = "set"
+	IncludeDefaultsFlag = "include-defaults"
+	MappingsFlag        = "mappings"
+	SettingsFlag        = "settings"
+
+	SourceFlag            = "source"
+	DestFlag              = "dest"
+	QueryFlag             = "query"
+	QueryDSLFlag          = "query-dsl"
+	MaxDocsFlag           = "max-docs"
+	SlicesFlag            = "slices"
+	RequestsPerSecondFlag = "requests-per-second"
+	RemoteFlag            = "remote"
+	RemoteHostFlag        = "remote-host"
+	RemoteSchemeFlag      = "remote-scheme"
+	RemotePortFlag        = "remote-port"
+	RemoteUsernameFlag    = "remote-username"
+	DetachFlag            = "detach"
+	TaskFlag              = "task"
+	RethrottleFlag        = "rethrottle"
+	CancelFlag            = "cancel"
+
+	SnapshotFirstFlag = "snapshot-first"
+	CloseOnlyFlag     = "close-only"
+)
+
 func NewIndexCmd() *cobra.Command {
 	// subcommands
 	indexCmd.AddCommand(
 		indexListCmd,
 		indexDeleteCmd,
 		indexCreateCmd,
+		indexMappingCmd,
+		indexSettingsCmd,
+		indexDescribeCmd,
+		indexReindexCmd,
 	)
+	indexMappingCmd.AddCommand(indexMappingGetCmd, indexMappingPutCmd)
+	indexSettingsCmd.AddCommand(indexSettingsGetCmd, indexSettingsPutCmd)
 	return indexCmd
 }
 
@@ -32,5 +67,41 @@ var indexCmd = &cobra.Command{
 
 func init() {
 	indexDeleteCmd.Flags().Bool(ConfirmFlag, false, "delete index without confirmation")
+	indexDeleteCmd.Flags().String(SnapshotFirstFlag, "", "snapshot repository to take the snapshot of the indices in before they are deleted.")
+	indexDeleteCmd.Flags().Bool(CloseOnlyFlag, false, "close the indices instead of deleting them.")
 	indexListCmd.Flags().Bool(FlagAll, false, "show all indices, including hidden ones(starting with '.').")
+	indexCreateCmd.Flags().String(MappingsFlag, "", "file with the index mappings, '-' reads stdin.")
+	indexCreateCmd.Flags().String(SettingsFlag, "", "file with the index settings, '-' reads stdin.")
+	indexSettingsGetCmd.Flags().Bool(IncludeDefaultsFlag, false, "show the default values of the settings which are not set explicitly.")
+	for _, putCmd := range []*cobra.Command{indexMappingPutCmd, indexSettingsPutCmd} {
+		putCmd.Flags().StringP(DataFlag, "d", "", "request body.")
+		putCmd.Flags().StringP(DataFileFlag, "f", "", "file with the request body, '-' reads stdin.")
+		putCmd.Flags().Bool(ConfirmFlag, false, "update the indices matching the pattern without confirmation")
+		putCmd.MarkFlagsMutuallyExclusive(DataFlag, DataFileFlag)
+	}
+	indexSettingsPutCmd.Flags().StringArray(SetFlag, nil, "setting in the form key=value, repeatable.")
+	indexSettingsPutCmd.MarkFlagsMutuallyExclusive(SetFlag, DataFlag)
+	indexSettingsPutCmd.MarkFlagsMutuallyExclusive(SetFlag, DataFileFlag)
+	indexReindexCmd.Flags().String(SourceFlag, "", "source index, comma separated list of indices or pattern.")
+	indexReindexCmd.Flags().String(DestFlag, "", "destination index.")
+	indexReindexCmd.Flags().StringP(QueryFlag, "q", "", "query string filtering the source documents.")
+	indexReindexCmd.Flags().String(QueryDSLFlag, "", "Query DSL JSON filtering the source documents.")
+	indexReindexCmd.Flags().Int(MaxDocsFlag, 0, "maximal number of documents to reindex, 0 means all.")
+	indexReindexCmd.Flags().String(SlicesFlag, "", "number of parallel slices or 'auto'.")
+	indexReindexCmd.Flags().Int(RequestsPerSecondFlag, -1, "throttling of the reindex in requests per second, -1 means unthrottled.")
+	indexReindexCmd.Flags().String(RemoteFlag, "", "name of the remote configured by 'ccr create' to reindex from.")
+	indexReindexCmd.Flags().String(RemoteHostFlag, "", "HTTP address of the remote cluster to reindex from, e.g. https://leader:9200.")
+	indexReindexCmd.Flags().String(RemoteSchemeFlag, "https", "scheme of the remote cluster named by '--remote'.")
+	indexReindexCmd.Flags().Int(RemotePortFlag, 9200, "HTTP port of the remote cluster named by '--remote'.")
+	indexReindexCmd.Flags().String(RemoteUsernameFlag, "", "user of the remote cluster.")
+	indexReindexCmd.Flags().Bool(DetachFlag, false, "print the task identifier and exit without watching the reindex.")
+	indexReindexCmd.Flags().String(TaskFlag, "", "identifier of the running reindex task to watch, rethrottle or cancel.")
+	indexReindexCmd.Flags().Int(RethrottleFlag, 0, "change the throttling of the running reindex, -1 means unthrottled.")
+	indexReindexCmd.Flags().Bool(CancelFlag, false, "cancel the running reindex.")
+	indexReindexCmd.Flags().Bool(ConfirmFlag, false, "cancel the reindex without confirmation")
+	indexReindexCmd.MarkFlagsMutuallyExclusive(QueryFlag, QueryDSLFlag)
+	indexReindexCmd.MarkFlagsMutuallyExclusive(RemoteFlag, RemoteHostFlag)
+	indexReindexCmd.MarkFlagsMutuallyExclusive(RethrottleFlag, CancelFlag)
+	indexReindexCmd.MarkFlagsMutuallyExclusive(TaskFlag, SourceFlag)
+	indexReindexCmd.MarkFlagsMutuallyExclusive(TaskFlag, DestFlag)
 }
@@ -2,6 +2,8 @@ package index
 
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
 	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
 	"github.com/spf13/cobra"
 )
@@ -9,20 +11,37 @@ import (
 var indexCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: " creates index",
-	Long:  `Create index in the OpenSearch cluster`,
+	Long: `Create index in the OpenSearch cluster.
+The settings and mappings are read from the files given by '--settings' and '--mappings'('-' reads stdin, only one of them can),
+the content may be either wrapped into the 'settings' and 'mappings' keys or not.`,
 	Example: `
 opensearch-cli index create <Index Name>
+opensearch-cli index create orders --settings settings.json --mappings mappings.json
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		client := api.NewFromCmd(cmd)
 		if len(args) == 0 || args[0] == "" {
 			log.Fatal().Msg("index name is required")
 		} else if gu.ContainsWildcard(args[0]) {
 			log.Fatal().Msg("wildcard is not allowed")
-		} else {
-			if err := client.CreateIndex(args[0]); err != nil {
-				log.Fatal().Err(err)
-			}
+		} else if flagutils.GetStringFlag(cmd.Flags(), SettingsFlag) == "-" && flagutils.GetStringFlag(cmd.Flags(), MappingsFlag) == "-" {
+			log.Fatal().Msgf("flags '--%s' and '--%s' can't both read stdin", SettingsFlag, MappingsFlag)
+		}
+		settings, err := flagutils.GetFileFlag(cmd.Flags(), SettingsFlag, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal().Msgf("unable to read settings:%v", err)
+		}
+		mappings, err := flagutils.GetFileFlag(cmd.Flags(), MappingsFlag, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal().Msgf("unable to read mappings:%v", err)
+		}
+		body, err := indices.CreateBody(settings, mappings)
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		client := api.NewFromCmd(cmd)
+		if err := client.CreateIndexWithBody(args[0], body); err != nil {
+			log.Fatal().Msgf("failed to create index '%s':%v", args[0], err)
 		}
+		log.Info().Msgf("index '%s' created", args[0])
 	},
 }
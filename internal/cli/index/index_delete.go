@@ -2,15 +2,20 @@ package index
 
 import (
 	"fmt"
+	"github.com/dalet-oss/opensearch-cli/internal/cli/snapshot"
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/snapshots"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
 	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/dalet-oss/opensearch-cli/pkg/ux/targets"
 	"github.com/spf13/cobra"
 	"slices"
-	"sort"
 	"strings"
+	"time"
 )
 
 const ConfirmFlag = "approve"
@@ -18,69 +23,102 @@ const ConfirmFlag = "approve"
 var indexDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "⚠️deletes index.",
-	Long:  `Delete index in the OpenSearch cluster`,
+	Long: `
+Delete index in the OpenSearch cluster, or all indices compliant with the pattern.
+
+'--snapshot-first <repository>' takes the snapshot of the indices before they are deleted, nothing is deleted
+if the snapshot doesn't succeed. '--close-only' closes the indices instead of deleting them, the closed index
+keeps its data and can be opened again.
+The indices matching the 'protectedIndices' patterns of the config 'params' are never deleted or closed.
+The indices are processed one by one, the failure of one index doesn't stop the others, the summary
+of the batch is printed at the end and the command fails if any index failed.
+`,
 	Example: fmt.Sprintf(`
 opensearch-cli index delete <- will show interactive prompt with the list of indices
 opensearch-cli index delete index1 <- will delete index1 from the OpenSearch cluster
 opensearch-cli index delete index* <- will delete indices compliant with the pattern from the OpenSearch cluster
+opensearch-cli index delete 'logs-2023*' --snapshot-first backup <- will snapshot the indices to the backup repository first
+opensearch-cli index delete 'logs-2023*' --close-only <- will close the indices instead of deleting them
 %s
 `, gu.WildHelp),
 	Run: func(cmd *cobra.Command, args []string) {
 		// method
 		client := api.NewFromCmd(cmd)
-		indexToDelete := ""
-		registeredIndices, indexListErr := client.GetIndexList()
-		if indexListErr != nil {
-			log.Fatal().Err(indexListErr)
+		selected := targets.SelectIndices(client, args, "Select index for removal")
+		if len(selected) == 0 {
+			return
 		}
-		indexNames := fp.Map(registeredIndices, func(info api.IndexInfo) string {
-			return info.Index
-		})
-		sort.Strings(indexNames)
-		if len(args) == 0 {
-			indexToDelete = prompts.SelectivePrompt("Select index for removal", indexNames)
-		} else if !gu.ContainsWildcard(args[0]) {
-			// check if there's index -> delete
-			indexToDelete = args[0]
-			if idx := slices.IndexFunc(registeredIndices, func(info api.IndexInfo) bool {
-				return info.Index == indexToDelete
-			}); idx == -1 {
-				log.Fatal().Msgf("❌index '%s' not found", indexToDelete)
-			}
-			if flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) ||
-				prompts.IsOk(
-					prompts.QuestionPrompt(
-						fmt.Sprintf(
-							"[context:%s]Are you sure you want to delete index '%s'?", client.Config.Current,
-							indexToDelete))) {
-				indexDeleteErr := client.DeleteIndex(indexToDelete)
-				if indexDeleteErr != nil {
-					log.Fatal().Err(indexDeleteErr)
-				}
+		closeOnly := flagutils.GetBoolFlag(cmd.Flags(), CloseOnlyFlag)
+		operation := fp.Ternary("close", "delete", closeOnly)
+		var results indices.OperationResults
+		var allowed []string
+		for _, index := range selected {
+			if pattern := client.Config.ProtectedBy(index); pattern != "" {
+				log.Warn().Msgf("index '%s' is protected by '%s' pattern", index, pattern)
+				results = append(results, indices.OperationResult{
+					Index: index, Operation: operation, Status: indices.ResultSkipped, Details: fmt.Sprintf("protected by '%s'", pattern),
+				})
+			} else {
+				allowed = append(allowed, index)
 			}
-		} else {
-			filtered := fp.Filter(indexNames, gu.GetMatchFunc(args[0]))
-			sort.Strings(filtered)
-			if len(filtered) == 0 {
-				log.Warn().Msgf("no indices found for %s expression [total %d in the cluster]", args[0], len(indexNames))
-				return
+		}
+		if len(allowed) == 0 {
+			log.Fatal().Msgf("❌refusing to %s protected %s", operation, fp.Ternary("index", "indices", len(selected) == 1))
+		}
+		subject := fp.Ternary("these indices", fmt.Sprintf("index '%s'", allowed[0]), len(selected) > 1)
+		if len(allowed) < len(selected) {
+			log.Info().Msgf("%s %d %s:\n%s",
+				fp.Ternary("closing", "deleting", closeOnly), len(allowed), fp.Ternary("index", "indices", len(allowed) == 1), strings.Join(allowed, "\n"))
+		}
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to %s %s?", client.Config.Current, operation, subject))) {
+			return
+		}
+		if repository := flagutils.GetStringFlag(cmd.Flags(), SnapshotFirstFlag); repository != "" {
+			snapshotBeforeDelete(client, repository, allowed)
+		}
+		for _, index := range allowed {
+			var err error
+			if closeOnly {
+				err = client.CloseIndex(index)
 			} else {
-				log.Info().Msgf(
-					"found %d %s for %s expression:\n%s",
-					len(filtered), fp.Ternary("index", "indices", len(filtered) == 1), args[0], strings.Join(filtered, "\n"))
-				if flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) ||
-					prompts.IsOk(
-						prompts.QuestionPrompt(
-							fmt.Sprintf(
-								"[context:%s]Are you sure you want to delete these indices?", client.Config.Current))) {
-					for _, index := range filtered {
-						log.Info().Msgf("deleting index '%s'", index)
-						if err := client.DeleteIndex(index); err != nil {
-							log.Fatal().Msgf("fail to delete index:%v", err)
-						}
-					}
-				}
+				err = client.DeleteIndex(index)
+			}
+			if err != nil {
+				log.Error().Msgf("fail to %s index '%s':%v", operation, index, err)
+				results = append(results, indices.OperationResult{Index: index, Operation: operation, Status: indices.ResultFailed, Details: err.Error()})
+				continue
 			}
+			log.Info().Msgf("index '%s' %s", index, fp.Ternary("closed", "deleted", closeOnly))
+			results = append(results, indices.OperationResult{Index: index, Operation: operation, Status: indices.ResultDone})
+		}
+		if len(results) > 1 {
+			slices.SortStableFunc(results, func(a, b indices.OperationResult) int {
+				return strings.Compare(a.Index, b.Index)
+			})
+			printutils.FromFlags(cmd.Flags()).PrintOrDie(results)
+		}
+		if failed := results.Count(indices.ResultFailed); failed > 0 {
+			log.Fatal().Msgf("fail to %s %d of %d indices", operation, failed, len(allowed))
 		}
 	},
 }
+
+// snapshotBeforeDelete takes the snapshot of the indices in the repository and waits for it,
+// terminates the program if the snapshot doesn't succeed.
+func snapshotBeforeDelete(client *api.OpensearchWrapper, repository string, indexNames []string) {
+	name := "pre-delete-" + time.Now().UTC().Format("2006.01.02-15.04.05")
+	if err := client.CreateSnapshot(repository, name, snapshots.CreateOptions{Indices: indexNames}); err != nil {
+		log.Fatal().Msgf("failed to create snapshot, the indices are left untouched:%v", err)
+	}
+	log.Info().Msgf("snapshot '%s' started in repository '%s'", name, repository)
+	taken, err := snapshot.WatchSnapshot(client, repository, name)
+	if err != nil {
+		log.Fatal().Msgf("failed to get snapshot status, the indices are left untouched:%v", err)
+	} else if taken.State != snapshots.StateSuccess {
+		log.Fatal().Msgf("snapshot '%s' is %s, the indices are left untouched", name, taken.State)
+	}
+	log.Info().Msgf("snapshot '%s' of %d indices created in repository '%s'", name, len(indexNames), repository)
+}
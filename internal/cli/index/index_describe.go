@@ -0,0 +1,43 @@
+package index
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var indexDescribeCmd = &cobra.Command{
+	Use:   "describe <index|alias>",
+	Short: "shows the index details.",
+	Long: `
+Show the overview of the index: health, doc counts, sizes, aliases and the main dynamic settings,
+followed by the shard allocation and the mapped fields. '-o wide' shows all the settings,
+'-o json' and '-o yaml' print the full description including the mappings definition.
+`,
+	Example: `
+opensearch-cli index describe orders
+opensearch-cli index describe orders-alias -o yaml
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		description, err := api.NewFromCmd(cmd).DescribeIndex(args[0])
+		if err != nil {
+			log.Fatal().Msgf("failed to describe index:%v", err)
+		}
+		printer := printutils.FromFlags(cmd.Flags())
+		printer.PrintOrDie(description)
+		if printer.Format != printutils.FormatTable && printer.Format != printutils.FormatWide {
+			return
+		}
+		// the table formats show the shards and the mapped fields as separate tables
+		for _, section := range []printutils.Tabular{
+			description.Shards,
+			indices.Mappings{description.Name: {Mappings: description.Mappings}},
+		} {
+			_, _ = fmt.Fprintln(printer.Out)
+			printer.PrintOrDie(section)
+		}
+	},
+}
@@ -1,8 +1,8 @@
 package index
 
 import (
-	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
 	"strings"
@@ -17,9 +17,15 @@ var indexListCmd = &cobra.Command{
 	Long:    `show all indices in the OpenSearch cluster`,
 	Example: `opensearch-cli index [list|ls]`,
 	Run: func(cmd *cobra.Command, args []string) {
-		indices, indexListErr := api.NewFromCmd(cmd).GetIndexList()
+		client := api.NewFromCmd(cmd)
+		indices, indexListErr := client.GetIndexList()
 		if indexListErr != nil {
-			log.Fatal().Err(indexListErr)
+			log.Fatal().Msgf("failed to get index list:%v", indexListErr)
+		}
+		if aliases, err := client.GetAliases(); err != nil {
+			log.Warn().Msgf("unable to get aliases:%v", err)
+		} else {
+			indices = indices.WithAliases(aliases)
 		}
 		if v, _ := cmd.Flags().GetBool(FlagAll); !v {
 			indices = slices.DeleteFunc(indices, func(info api.IndexInfo) bool {
@@ -29,8 +35,6 @@ var indexListCmd = &cobra.Command{
 		slices.SortFunc(indices, func(a, b api.IndexInfo) int {
 			return strings.Compare(a.Index, b.Index)
 		})
-		for _, index := range indices {
-			fmt.Println(index)
-		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(indices)
 	},
 }
@@ -0,0 +1,74 @@
+package index
+
+import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var indexMappingCmd = &cobra.Command{
+	Use:   "mapping",
+	Short: "index mapping commands",
+	Long:  `Set of commands for the index mappings management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			log.Err(err).Msg("failed to show help")
+		}
+	},
+}
+
+var indexMappingGetCmd = &cobra.Command{
+	Use:   "get <index|pattern>",
+	Short: "shows the index mappings.",
+	Long: `
+Show the mapped fields of the index, the comma separated list of indices or the indices compliant with the pattern.
+Use '-o json' to get the mappings definition.
+`,
+	Example: `
+opensearch-cli index mapping get orders
+opensearch-cli index mapping get 'logs-*' -o json
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		mappings, err := api.NewFromCmd(cmd).GetIndexMappings(args[0])
+		if err != nil {
+			log.Fatal().Msgf("failed to get mappings:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(mappings)
+	},
+}
+
+var indexMappingPutCmd = &cobra.Command{
+	Use:   "put <index|pattern>",
+	Short: "adds fields to the index mappings.",
+	Long: `
+Add the fields to the mappings of the index from '--data', or from the file given by '--data-file'('-' reads stdin).
+The type of the existing field can't be changed, reindex the data into the new index instead.
+`,
+	Example: `
+opensearch-cli index mapping put orders -d '{"properties":{"status":{"type":"keyword"}}}'
+opensearch-cli index mapping put 'orders-*' -f mappings.json --approve
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		body, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal().Msgf("unable to read mappings:%v", err)
+		} else if body == nil {
+			log.Fatal().Msgf("mappings are required, use '--%s' or '--%s'", DataFlag, DataFileFlag)
+		} else if !json.Valid(body) {
+			log.Fatal().Msg("mappings are not a valid JSON")
+		}
+		client := api.NewFromCmd(cmd)
+		targets, ok := confirmTargets(cmd, client, args[0], "update mappings of")
+		if !ok {
+			return
+		}
+		if err := client.PutIndexMappings(targets, body); err != nil {
+			log.Fatal().Msgf("failed to update mappings:%v", err)
+		}
+		log.Info().Msgf("mappings of '%s' updated", targets)
+	},
+}
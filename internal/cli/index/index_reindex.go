@@ -0,0 +1,167 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/reindex"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/tasks"
+	"github.com/dalet-oss/opensearch-cli/pkg/consts"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"os"
+	"strings"
+	"time"
+)
+
+// reindexPollInterval is the interval between the task status requests while the reindex is watched.
+const reindexPollInterval = 2 * time.Second
+
+var indexReindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "copies documents from the source indices to the destination index.",
+	Long: `
+Start the reindex of the source indices(the comma separated list or the pattern) into the destination index
+in the background and watch its task, rendering the progress with the rate and the estimated time left.
+The interrupted command doesn't stop the reindex, use '--task' to watch it again and '--cancel' to stop it.
+
+The documents can be filtered by '--query'(query string syntax) or '--query-dsl'(Query DSL), the reindex
+can be split into the parallel '--slices' and throttled by '--requests-per-second'.
+
+The documents are reindexed from the remote cluster with '--remote-host', or with '--remote' naming the remote
+configured by 'ccr create', its transport address is reused with the HTTP '--remote-port'.
+The remote host must be allowed by the 'reindex.remote.allowlist' setting of the destination cluster.
+The password of '--remote-username' is read from the ` + consts.EnvRemotePassword + ` environment variable or prompted.
+`,
+	Example: `
+opensearch-cli index reindex --source orders --dest orders-v2
+opensearch-cli index reindex --source 'logs-2024.*' --dest logs-2024 --slices auto --requests-per-second 500
+opensearch-cli index reindex --source orders --dest orders-errors -q 'status:failed' --detach
+opensearch-cli index reindex --source orders --dest orders --remote pyramid-replication --remote-username admin
+opensearch-cli index reindex --task oTUltX4IQMOUUVeiohTt8A:12345
+opensearch-cli index reindex --task oTUltX4IQMOUUVeiohTt8A:12345 --rethrottle -1
+opensearch-cli index reindex --task oTUltX4IQMOUUVeiohTt8A:12345 --cancel
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewFromCmd(cmd)
+		taskID := flagutils.GetStringFlag(cmd.Flags(), TaskFlag)
+		if taskID == "" {
+			if cmd.Flags().Changed(RethrottleFlag) || flagutils.GetBoolFlag(cmd.Flags(), CancelFlag) {
+				log.Fatal().Msgf("flag '--%s' is required to change the running reindex", TaskFlag)
+			}
+			var err error
+			if taskID, err = client.Reindex(reindexOptions(cmd, client)); err != nil {
+				log.Fatal().Msgf("failed to start reindex:%v", err)
+			}
+			log.Info().Msgf("reindex task '%s' started", taskID)
+			if flagutils.GetBoolFlag(cmd.Flags(), DetachFlag) {
+				fmt.Println(taskID)
+				return
+			}
+		} else if cmd.Flags().Changed(RethrottleFlag) {
+			rps := flagutils.GetIntFlag(cmd.Flags(), RethrottleFlag)
+			if err := client.RethrottleReindex(taskID, rps); err != nil {
+				log.Fatal().Msgf("failed to rethrottle reindex:%v", err)
+			}
+			log.Info().Msgf("reindex task '%s' is throttled to %s", taskID, fp.Ternary("unlimited", fmt.Sprintf("%d requests per second", rps), rps < 0))
+			return
+		} else if flagutils.GetBoolFlag(cmd.Flags(), CancelFlag) {
+			if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+				!prompts.IsOk(
+					prompts.QuestionPrompt(
+						fmt.Sprintf("[context:%s]Are you sure you want to cancel reindex task '%s'?", client.Config.Current, taskID))) {
+				return
+			}
+			if err := client.CancelTask(taskID); err != nil {
+				log.Fatal().Msgf("failed to cancel reindex:%v", err)
+			}
+			log.Info().Msgf("reindex task '%s' is cancelled", taskID)
+			return
+		}
+		status := watchReindex(client, taskID)
+		printer := printutils.FromFlags(cmd.Flags())
+		printer.PrintOrDie(status)
+		if len(status.Failures) > 0 {
+			if !printer.IsStructured() {
+				for _, failure := range status.Failures {
+					log.Error().Msgf("%s", failure)
+				}
+			}
+			log.Fatal().Msgf("reindex task '%s' completed with %d failures", taskID, len(status.Failures))
+		}
+	},
+}
+
+// reindexOptions returns the reindex options from the flags, the remote host is resolved by the name of the remote.
+func reindexOptions(cmd *cobra.Command, client *api.OpensearchWrapper) reindex.Options {
+	opts := reindex.Options{
+		Source:      strings.Split(flagutils.GetNotEmptyStringFlag(cmd.Flags(), SourceFlag), ","),
+		Dest:        flagutils.GetNotEmptyStringFlag(cmd.Flags(), DestFlag),
+		QueryString: flagutils.GetStringFlag(cmd.Flags(), QueryFlag),
+		MaxDocs:     flagutils.GetIntFlag(cmd.Flags(), MaxDocsFlag),
+		Slices:      flagutils.GetStringFlag(cmd.Flags(), SlicesFlag),
+	}
+	if query := flagutils.GetStringFlag(cmd.Flags(), QueryDSLFlag); query != "" {
+		opts.Query = json.RawMessage(query)
+	}
+	if cmd.Flags().Changed(RequestsPerSecondFlag) {
+		opts.RequestsPerSecond = fp.AsPointer(flagutils.GetIntFlag(cmd.Flags(), RequestsPerSecondFlag))
+	}
+	host := flagutils.GetStringFlag(cmd.Flags(), RemoteHostFlag)
+	if remote := flagutils.GetStringFlag(cmd.Flags(), RemoteFlag); remote != "" {
+		var err error
+		host, err = client.RemoteReindexHost(
+			remote, flagutils.GetStringFlag(cmd.Flags(), RemoteSchemeFlag), flagutils.GetIntFlag(cmd.Flags(), RemotePortFlag))
+		if err != nil {
+			log.Fatal().Msgf("unable to resolve remote '%s':%v", remote, err)
+		}
+		log.Info().Msgf("reindex from remote '%s' at %s", remote, host)
+	}
+	if host != "" {
+		opts.Remote = &reindex.Remote{Host: host, Username: flagutils.GetStringFlag(cmd.Flags(), RemoteUsernameFlag)}
+		if opts.Remote.Username != "" {
+			if opts.Remote.Password = os.Getenv(consts.EnvRemotePassword); opts.Remote.Password == "" {
+				opts.Remote.Password = prompts.SecretPrompt(fmt.Sprintf("Password of '%s' at %s", opts.Remote.Username, host))
+			}
+		}
+	}
+	if err := opts.Validate(); err != nil {
+		log.Fatal().Msgf("%v", err)
+	}
+	return opts
+}
+
+// watchReindex polls the reindex task until it is completed rendering its progress, returns the final status.
+func watchReindex(client *api.OpensearchWrapper, taskID string) reindex.Status {
+	progress := printutils.NewProgress("reindex", 0)
+	result, err := client.WaitForTask(taskID, reindexPollInterval, func(result tasks.TaskResult) {
+		if len(result.Error) > 0 {
+			return
+		}
+		raw := fp.Ternary(result.Response, result.Task.Status, result.Completed)
+		var status reindex.Status
+		if err := json.Unmarshal(raw, &status); err != nil {
+			log.Fatal().Msgf("unexpected reindex task status:%v", err)
+		}
+		progress.Total = status.Total
+		if result.Completed {
+			progress.Finish(status.Processed(), status.Details(result.Task.RunningTime()))
+		} else {
+			progress.Update(status.Processed(), status.Details(result.Task.RunningTime()))
+		}
+	})
+	if err != nil {
+		log.Fatal().Msgf("failed to get reindex task:%v", err)
+	} else if len(result.Error) > 0 {
+		log.Fatal().Msgf("reindex task '%s' failed:%s", taskID, result.Error)
+	}
+	var status reindex.Status
+	if err := json.Unmarshal(result.Response, &status); err != nil {
+		log.Fatal().Msgf("unexpected reindex response:%v", err)
+	}
+	return status
+}
@@ -0,0 +1,109 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var indexSettingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "index settings commands",
+	Long:  `Set of commands for the index settings management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			log.Err(err).Msg("failed to show help")
+		}
+	},
+}
+
+var indexSettingsGetCmd = &cobra.Command{
+	Use:   "get <index|pattern>",
+	Short: "shows the index settings.",
+	Long: `
+Show the flat settings of the index, the comma separated list of indices or the indices compliant with the pattern.
+'--include-defaults' adds the default values of the settings which are not set explicitly.
+`,
+	Example: `
+opensearch-cli index settings get orders
+opensearch-cli index settings get orders --include-defaults -o wide
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		settings, err := api.NewFromCmd(cmd).GetIndexSettings(args[0], flagutils.GetBoolFlag(cmd.Flags(), IncludeDefaultsFlag))
+		if err != nil {
+			log.Fatal().Msgf("failed to get settings:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(settings)
+	},
+}
+
+var indexSettingsPutCmd = &cobra.Command{
+	Use:   "put <index|pattern>",
+	Short: "updates the dynamic index settings.",
+	Long: `
+Update the dynamic settings of the index with '--set key=value'(repeatable, the 'index.' prefix is optional,
+the empty value resets the setting to its default), or with the settings body from '--data' or '--data-file'('-' reads stdin).
+`,
+	Example: `
+opensearch-cli index settings put orders --set number_of_replicas=2 --set refresh_interval=30s
+opensearch-cli index settings put 'logs-*' --set refresh_interval= --approve
+opensearch-cli index settings put orders -d '{"index":{"blocks.write":true}}'
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		body, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal().Msgf("unable to read settings:%v", err)
+		}
+		if pairs := flagutils.GetStringArrayFlag(cmd.Flags(), SetFlag); len(pairs) > 0 {
+			settings, err := indices.SettingsBody(pairs)
+			if err != nil {
+				log.Fatal().Msgf("%v", err)
+			}
+			body, _ = json.Marshal(settings)
+		}
+		if body == nil {
+			log.Fatal().Msgf("settings are required, use '--%s', '--%s' or '--%s'", SetFlag, DataFlag, DataFileFlag)
+		} else if !json.Valid(body) {
+			log.Fatal().Msg("settings are not a valid JSON")
+		}
+		client := api.NewFromCmd(cmd)
+		targets, ok := confirmTargets(cmd, client, args[0], "update settings of")
+		if !ok {
+			return
+		}
+		if err := client.PutIndexSettings(targets, body); err != nil {
+			log.Fatal().Msgf("failed to update settings:%v", err)
+		}
+		log.Info().Msgf("settings of '%s' updated", targets)
+	},
+}
+
+// confirmTargets resolves the index expression and asks for the confirmation if it matches more than one index,
+// returns the comma separated list of the indices and false if the action is not confirmed.
+func confirmTargets(cmd *cobra.Command, client *api.OpensearchWrapper, expression, action string) (string, bool) {
+	if !gu.ContainsWildcard(expression) && !strings.Contains(expression, ",") {
+		return expression, true
+	}
+	targets, err := client.ResolveIndices(expression)
+	if err != nil {
+		log.Fatal().Msgf("%v", err)
+	}
+	log.Info().Msgf(
+		"found %d %s for %s expression:\n%s",
+		len(targets), fp.Ternary("index", "indices", len(targets) == 1), expression, strings.Join(targets, "\n"))
+	confirmed := flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) ||
+		prompts.IsOk(
+			prompts.QuestionPrompt(
+				fmt.Sprintf("[context:%s]Are you sure you want to %s these indices?", client.Config.Current, action)))
+	return strings.Join(targets, ","), confirmed
+}
@@ -0,0 +1,54 @@
+package ism
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/spf13/cobra"
+)
+
+var log = logging.Logger()
+
+const (
+	ConfirmFlag  = "approve"
+	DataFlag     = "data"
+	DataFileFlag = "data-file"
+	StateFlag    = "state"
+	FailedFlag   = "failed"
+)
+
+func NewIsmCmd() *cobra.Command {
+	// subcommands
+	ismCmd.AddCommand(
+		ismPolicyCmd,
+		ismAttachCmd,
+		ismExplainCmd,
+		ismRetryCmd,
+	)
+	ismPolicyCmd.AddCommand(
+		ismPolicyListCmd,
+		ismPolicyGetCmd,
+		ismPolicyPutCmd,
+		ismPolicyDeleteCmd,
+	)
+	return ismCmd
+}
+
+// ismCmd represents the ism command
+var ismCmd = &cobra.Command{
+	Use:   "ism",
+	Short: "index state management commands",
+	Long:  `Set of commands for the Index State Management(ISM) policies and the managed indices`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.HasAvailableSubCommands() {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		}
+	},
+}
+
+// subject returns the description of the indices used in the confirmation prompts.
+func subject(indexNames []string) string {
+	return fp.Ternary(fmt.Sprintf("%d indices", len(indexNames)), fmt.Sprintf("index '%s'", indexNames[0]), len(indexNames) > 1)
+}
@@ -0,0 +1,64 @@
+package ism
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var ismAttachCmd = &cobra.Command{
+	Use:   "attach <indices> <policy>",
+	Short: "attaches the ISM policy to the indices.",
+	Long: fmt.Sprintf(`
+Attach the ISM policy to the indices selected by the comma separated names or patterns.
+The indices which are already managed by a policy keep it and are reported as failed,
+the summary of the batch is printed and the command fails if any index failed.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli ism attach 'logs-2024*' hot-warm-delete
+opensearch-cli ism attach logs-2024.06.01,logs-2024.06.02 hot-warm-delete --approve
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		policyID := args[1]
+		client := api.NewFromCmd(cmd)
+		if document, err := client.GetPolicy(policyID); err != nil {
+			log.Fatal().Msgf("failed to get ISM policy:%v", err)
+		} else if document == nil {
+			log.Fatal().Msgf("ISM policy '%s' not found", policyID)
+		}
+		indexNames, err := client.ResolveIndices(args[0])
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		log.Info().Msgf("indices to attach:\n%s", strings.Join(indexNames, "\n"))
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to attach ISM policy '%s' to %s?",
+						client.Config.Current, policyID, subject(indexNames)))) {
+			return
+		}
+		result, err := client.AddPolicy(indexNames, policyID)
+		if err != nil {
+			log.Fatal().Msgf("failed to attach ISM policy:%v", err)
+		}
+		results := result.Results("attach", indexNames)
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(results)
+		if failed := results.Count(indices.ResultFailed); failed > 0 {
+			log.Fatal().Msgf("fail to attach ISM policy '%s' to %d of %d indices", policyID, failed, len(indexNames))
+		}
+		log.Info().Msgf("ISM policy '%s' attached to %d indices", policyID, result.UpdatedIndices)
+	},
+}
+
+func init() {
+	ismAttachCmd.Flags().Bool(ConfirmFlag, false, "attach the policy without confirmation")
+}
@@ -0,0 +1,47 @@
+package ism
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/ism"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var ismExplainCmd = &cobra.Command{
+	Use:   "explain <indices>",
+	Short: "shows the ISM state of the indices.",
+	Long: fmt.Sprintf(`
+Show the policy, the current state, the action and the step status of the indices selected by the comma separated
+names or patterns, the info column holds the failure message of the failed index.
+'--failed' shows only the failed managed indices, which can be retried with 'ism retry'.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli ism explain logs-2024.06.01
+opensearch-cli ism explain 'logs-*' --failed -o wide
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewFromCmd(cmd)
+		indexNames, err := client.ResolveIndices(args[0])
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		explanations, err := client.ExplainIndices(indexNames)
+		if err != nil {
+			log.Fatal().Msgf("failed to explain indices:%v", err)
+		}
+		if flagutils.GetBoolFlag(cmd.Flags(), FailedFlag) {
+			explanations = fp.Filter(explanations, ism.ExplainedIndex.Failed)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(explanations)
+	},
+}
+
+func init() {
+	ismExplainCmd.Flags().Bool(FailedFlag, false, "show only the failed managed indices.")
+}
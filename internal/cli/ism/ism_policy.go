@@ -0,0 +1,140 @@
+package ism
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/ism"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+)
+
+var ismPolicyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "ISM policy commands",
+	Long:  `Set of commands for the ISM policies management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			log.Err(err).Msg("failed to show help")
+		}
+	},
+}
+
+var ismPolicyListCmd = &cobra.Command{
+	Use:     "list [pattern]",
+	Aliases: []string{"ls"},
+	Short:   "lists ISM policies.",
+	Long: fmt.Sprintf(`
+List the ISM policies, only the policies with the name compliant with the pattern if it is set.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli ism policy list
+opensearch-cli ism policy list 'logs*' -o json
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		policies, err := api.NewFromCmd(cmd).ListPolicies()
+		if err != nil {
+			log.Fatal().Msgf("failed to get ISM policies:%v", err)
+		}
+		if len(args) == 1 {
+			match := gu.GetMatchFunc(args[0])
+			policies = fp.Filter(policies, func(document ism.PolicyDocument) bool {
+				return match(document.ID)
+			})
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(policies)
+	},
+}
+
+var ismPolicyGetCmd = &cobra.Command{
+	Use:   "get <policy>",
+	Short: "shows the ISM policy.",
+	Long: `
+Show the states of the ISM policy, the default state is marked with '*'.
+The JSON output can be used as the body of the put command.
+`,
+	Example: `
+opensearch-cli ism policy get hot-warm-delete
+opensearch-cli ism policy get hot-warm-delete -o json > policy.json
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		document, err := api.NewFromCmd(cmd).GetPolicy(args[0])
+		if err != nil {
+			log.Fatal().Msgf("failed to get ISM policy:%v", err)
+		} else if document == nil {
+			log.Fatal().Msgf("ISM policy '%s' not found", args[0])
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(*document)
+	},
+}
+
+var ismPolicyPutCmd = &cobra.Command{
+	Use:   "put <policy>",
+	Short: "creates or updates the ISM policy.",
+	Long: `
+Create the ISM policy or update the existing one with the definition from '--data', or from the file given
+by '--data-file'('-' reads stdin). The definition is the policy, the body of the request '{"policy":{...}}'
+or the output of the get command.
+The updated policy is applied to the managed indices when they move to the next state.
+`,
+	Example: `
+opensearch-cli ism policy put hot-warm-delete -f policy.json
+opensearch-cli ism policy get hot-warm-delete -o json | opensearch-cli ism policy put hot-warm-delete-v2 -f -
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		data, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal().Msgf("unable to read policy:%v", err)
+		} else if data == nil {
+			log.Fatal().Msgf("policy is required, use '--%s' or '--%s'", DataFlag, DataFileFlag)
+		}
+		body, err := ism.PolicyBody(data)
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		created, err := api.NewFromCmd(cmd).PutPolicy(args[0], body)
+		if err != nil {
+			log.Fatal().Msgf("failed to put ISM policy:%v", err)
+		}
+		log.Info().Msgf("ISM policy '%s' %s", args[0], fp.Ternary("created", "updated", created))
+	},
+}
+
+var ismPolicyDeleteCmd = &cobra.Command{
+	Use:     "delete <policy>",
+	Aliases: []string{"rm"},
+	Short:   "⚠️deletes the ISM policy.",
+	Long:    `Delete the ISM policy, the indices managed by the policy are not affected.`,
+	Example: `
+opensearch-cli ism policy delete hot-warm-delete
+opensearch-cli ism policy delete hot-warm-delete --approve
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewFromCmd(cmd)
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to delete ISM policy '%s'?", client.Config.Current, args[0]))) {
+			return
+		}
+		if err := client.DeletePolicy(args[0]); err != nil {
+			log.Fatal().Msgf("failed to delete ISM policy:%v", err)
+		}
+		log.Info().Msgf("ISM policy '%s' deleted", args[0])
+	},
+}
+
+func init() {
+	ismPolicyPutCmd.Flags().StringP(DataFlag, "d", "", "policy definition.")
+	ismPolicyPutCmd.Flags().StringP(DataFileFlag, "f", "", "file with the policy definition, '-' reads stdin.")
+	ismPolicyPutCmd.MarkFlagsMutuallyExclusive(DataFlag, DataFileFlag)
+	ismPolicyDeleteCmd.Flags().Bool(ConfirmFlag, false, "delete the policy without confirmation")
+}
@@ -0,0 +1,72 @@
+package ism
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/ism"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var ismRetryCmd = &cobra.Command{
+	Use:   "retry <indices>",
+	Short: "retries the failed ISM action of the indices.",
+	Long: fmt.Sprintf(`
+Retry the failed action of the managed indices selected by the comma separated names or patterns,
+the indices which are not failed are skipped. '--state' restarts the indices from the state of the policy
+instead of the failed action. The summary of the batch is printed and the command fails if any index failed.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli ism retry logs-2024.06.01
+opensearch-cli ism retry 'logs-*' --state warm --approve
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewFromCmd(cmd)
+		indexNames, err := client.ResolveIndices(args[0])
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		explanations, err := client.ExplainIndices(indexNames)
+		if err != nil {
+			log.Fatal().Msgf("failed to explain indices:%v", err)
+		}
+		failed := fp.Map(fp.Filter(explanations, ism.ExplainedIndex.Failed), func(explained ism.ExplainedIndex) string {
+			return explained.Index
+		})
+		if len(failed) == 0 {
+			log.Warn().Msgf("no failed managed indices found for %s expression", args[0])
+			return
+		}
+		log.Info().Msgf(
+			"found %d failed %s:\n%s", len(failed), fp.Ternary("index", "indices", len(failed) == 1), strings.Join(failed, "\n"))
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to retry %s?", client.Config.Current, subject(failed)))) {
+			return
+		}
+		result, err := client.RetryIndices(failed, flagutils.GetStringFlag(cmd.Flags(), StateFlag))
+		if err != nil {
+			log.Fatal().Msgf("failed to retry indices:%v", err)
+		}
+		results := result.Results("retry", failed)
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(results)
+		if count := results.Count(indices.ResultFailed); count > 0 {
+			log.Fatal().Msgf("fail to retry %d of %d indices", count, len(failed))
+		}
+		log.Info().Msgf("%d indices retried", result.UpdatedIndices)
+	},
+}
+
+func init() {
+	ismRetryCmd.Flags().String(StateFlag, "", "state of the policy to restart the indices from, the failed action is retried by default.")
+	ismRetryCmd.Flags().Bool(ConfirmFlag, false, "retry the indices without confirmation")
+}
@@ -2,13 +2,31 @@ package replication
 
 import (
 	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/replication"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
 	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/dalet-oss/opensearch-cli/pkg/ux/targets"
 	"github.com/spf13/cobra"
+	"slices"
+	"strings"
+	"sync"
 )
 import "github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
 
 var log = logging.Logger()
 
+const (
+	ConfirmFlag     = "approve"
+	ConcurrencyFlag = "concurrency"
+	// DefaultConcurrency is the number of the indices processed in parallel by the bulk operations.
+	DefaultConcurrency = 4
+)
+
 func NewReplicationCmd() *cobra.Command {
 	// subcommands
 	replicationCmd.AddCommand(
@@ -39,3 +57,82 @@ Some of the commands support wildcard operations:
 		}
 	},
 }
+
+// runParallel applies the replication operation to the indices with at most concurrency operations in flight,
+// the failures are logged and reported in the results, which are sorted by the index name.
+func runParallel(indexNames []string, operation string, concurrency int, apply func(index string) error) indices.OperationResults {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	results := make(indices.OperationResults, 0, len(indexNames))
+	var mu sync.Mutex
+	queue := make(chan string)
+	wg := sync.WaitGroup{}
+	for range min(concurrency, len(indexNames)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range queue {
+				result := indices.OperationResult{Index: index, Operation: operation, Status: indices.ResultDone}
+				if err := apply(index); err != nil {
+					log.Error().Msgf("❌fail to %s replication of index '%s':%v", operation, index, err)
+					result.Status, result.Details = indices.ResultFailed, err.Error()
+				} else {
+					log.Info().Msgf("✅%s replication of index '%s'", operation, index)
+				}
+				mu.Lock()
+				results = append(results, result)
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, index := range indexNames {
+		queue <- index
+	}
+	close(queue)
+	wg.Wait()
+	slices.SortFunc(results, func(a, b indices.OperationResult) int {
+		return strings.Compare(a.Index, b.Index)
+	})
+	return results
+}
+
+// replicationOperation is the replication state change applied to the single follower index.
+type replicationOperation func(client *api.OpensearchWrapper, index string) (replication.AcknowledgedResponse, error)
+
+// runReplicationOperation applies the operation to the indices selected by the argument and prints the summary table.
+// The index pattern is resolved over the indices of the cluster and confirmed with the prompt unless '--approve' is set,
+// the indices are processed in parallel and the command fails after the summary if any of the operations failed.
+func runReplicationOperation(cmd *cobra.Command, args []string, operation string, apply replicationOperation) {
+	client := api.NewFromCmd(cmd)
+	selected := targets.SelectIndices(client, args, "Select index for query")
+	if len(selected) == 0 {
+		return
+	}
+	if len(args) > 0 && gu.ContainsWildcard(args[0]) {
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to %s replication of %d %s?",
+						client.Config.Current, operation, len(selected), fp.Ternary("index", "indices", len(selected) == 1)))) {
+			return
+		}
+	}
+	results := runParallel(selected, operation, flagutils.GetIntFlag(cmd.Flags(), ConcurrencyFlag), func(index string) error {
+		rsp, err := apply(client, index)
+		if err == nil && !rsp.Acknowledged {
+			err = fmt.Errorf("replication %s is not acknowledged", operation)
+		}
+		return err
+	})
+	printutils.FromFlags(cmd.Flags()).PrintOrDie(results)
+	if failed := results.Count(indices.ResultFailed); failed > 0 {
+		log.Fatal().Msgf("fail to %s replication of %d of %d indices", operation, failed, len(selected))
+	}
+}
+
+// addBulkFlags registers the flags of the commands accepting the index pattern.
+func addBulkFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(ConcurrencyFlag, DefaultConcurrency, "number of indices processed in parallel")
+	cmd.Flags().Bool(ConfirmFlag, false, "apply to the indices compliant with the pattern without confirmation")
+}
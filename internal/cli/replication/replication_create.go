@@ -1,12 +1,21 @@
 package replication
 
 import (
+	"errors"
+	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
 	"github.com/dalet-oss/opensearch-cli/pkg/api/types/replication"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"slices"
+	"sort"
+	"strings"
 )
 
 const (
@@ -15,18 +24,42 @@ const (
 	LeaderIndexFlag         = "leader-index"
 	LeaderClusterRoleFlag   = "cluster-role"
 	FollowerClusterRoleFlag = "follower-cluster-role"
+	LeaderContextFlag       = "leader-context"
+	FollowerPrefixFlag      = "follower-prefix"
+	FollowerSuffixFlag      = "follower-suffix"
+	RenamePatternFlag       = "rename-pattern"
+	RenameReplacementFlag   = "rename-replacement"
 )
 
 var replicationCreateCmd = &cobra.Command{
-	Use:     "create",
-	Short:   "Create index replication task",
-	Example: `opensearch-cli replication create --index <INDEX NAME> --leader leader --leader-index [--cluster-role leader-role] [--follower-cluster-role follower-role]`,
+	Use:   "create",
+	Short: "Create index replication task",
+	Long: `
+Create the replication task of the leader index, the follower index is created by the replication.
+If '--leader-index' is the pattern, the replication is started for every leader index compliant with the pattern.
+The leader indices are listed with the '--leader-context' context or, if it is not set, through the remote cluster
+connection '--leader'. The follower index names are derived from the leader index names: the '--rename-pattern'
+regular expression is replaced with '--rename-replacement', then '--follower-prefix' and '--follower-suffix' are added.
+The leader indices whose follower index already exists are skipped, the hidden indices are listed only
+if the pattern starts with '.'. The replication tasks are created by '--concurrency' parallel requests.
+`,
+	Example: `
+opensearch-cli replication create --index <INDEX NAME> --leader leader --leader-index <LEADER INDEX NAME> [--cluster-role leader-role] [--follower-cluster-role follower-role]
+opensearch-cli replication create --leader leader --leader-index 'logs-*' --follower-prefix dr-
+opensearch-cli replication create --leader leader --leader-context prod --leader-index 'orders-*' --rename-pattern '^orders-(.*)$' --rename-replacement 'orders-dr-${1}' --approve
+`,
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewFromCmd(cmd)
+		if gu.ContainsWildcard(flagutils.GetStringFlag(cmd.Flags(), LeaderIndexFlag)) {
+			createBulkReplication(cmd, client)
+			return
+		}
 		options := prepareReplicationCall(cmd.Flags(), client)
-		if err := client.CreateReplication(options, flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
+		result, err := client.CreateReplication(options)
+		if err != nil {
 			log.Fatal().Msgf("failed to create replication task:%v", err)
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
 	},
 }
 
@@ -35,28 +68,156 @@ var replicationCreateCmd = &cobra.Command{
 func prepareReplicationCall(flags *pflag.FlagSet, client *api.OpensearchWrapper) replication.StartReplicationReq {
 	opts := replication.StartReplicationReq{
 		Index: flagutils.GetNotEmptyStringFlag(flags, IndexNameFlag),
-		Body: replication.StartReplicationBody{
-			LeaderAlias: flagutils.GetNotEmptyStringFlag(flags, LeaderAliasFlag),
-			LeaderIndex: flagutils.GetNotEmptyStringFlag(flags, LeaderIndexFlag),
-		},
+		Body:  replicationBody(flags, client),
+	}
+	opts.Body.LeaderIndex = flagutils.GetNotEmptyStringFlag(flags, LeaderIndexFlag)
+	return opts
+}
+
+// replicationBody gathers the leader alias and, if the security plugin is enabled, the roles of the replication.
+func replicationBody(flags *pflag.FlagSet, client *api.OpensearchWrapper) replication.StartReplicationBody {
+	body := replication.StartReplicationBody{
+		LeaderAlias: flagutils.GetNotEmptyStringFlag(flags, LeaderAliasFlag),
 	}
 	plugins, queryPluginErr := client.PluginsList()
 	if queryPluginErr != nil {
 		log.Fatal().Msgf("fail to get plugin list:%v", queryPluginErr)
 	}
 	if api.HasPlugin(plugins, api.SecurityPlugin) {
-		opts.Body.UseRoles = replication.ReplicationRoles{
+		body.UseRoles = replication.ReplicationRoles{
 			LeaderClusterRole:   flagutils.GetNotEmptyStringFlag(flags, LeaderClusterRoleFlag),
 			FollowerClusterRole: flagutils.GetNotEmptyStringFlag(flags, FollowerClusterRoleFlag),
 		}
 	}
-	return opts
+	return body
+}
+
+// createBulkReplication starts the replication of every leader index compliant with the '--leader-index' pattern
+// and prints the per-index report.
+func createBulkReplication(cmd *cobra.Command, client *api.OpensearchWrapper) {
+	flags := cmd.Flags()
+	if flagutils.GetStringFlag(flags, IndexNameFlag) != "" {
+		log.Fatal().Msgf("flag '--%s' can't be used with the leader index pattern, use the rename flags instead", IndexNameFlag)
+	}
+	template, err := replication.NewRenameTemplate(
+		flagutils.GetStringFlag(flags, FollowerPrefixFlag),
+		flagutils.GetStringFlag(flags, FollowerSuffixFlag),
+		flagutils.GetStringFlag(flags, RenamePatternFlag),
+		flagutils.GetStringFlag(flags, RenameReplacementFlag),
+	)
+	if err != nil {
+		log.Fatal().Msgf("%v", err)
+	}
+	body := replicationBody(flags, client)
+	pattern := flagutils.GetStringFlag(flags, LeaderIndexFlag)
+	leaderIndices := listLeaderIndices(cmd, client, body.LeaderAlias, pattern)
+	if len(leaderIndices) == 0 {
+		log.Warn().Msgf("no leader indices found for %s expression", pattern)
+		return
+	}
+	followers, err := template.FollowerIndices(leaderIndices)
+	if err != nil {
+		log.Fatal().Msgf("%v", err)
+	}
+	registeredIndices, indexListErr := client.GetIndexList()
+	if indexListErr != nil {
+		log.Fatal().Msgf("failed to get indices:%v", indexListErr)
+	}
+	existing := fp.Map(registeredIndices, func(info api.IndexInfo) string { return info.Index })
+	var results indices.OperationResults
+	leaders := map[string]string{}
+	var plan []string
+	for _, leaderIndex := range leaderIndices {
+		follower := followers[leaderIndex]
+		if slices.Contains(existing, follower) {
+			results = append(results, indices.OperationResult{
+				Index: follower, Operation: "start", Status: indices.ResultSkipped, Details: "follower index exists",
+			})
+			continue
+		}
+		leaders[follower] = leaderIndex
+		plan = append(plan, fmt.Sprintf("%s:%s -> %s", body.LeaderAlias, leaderIndex, follower))
+	}
+	if len(leaders) == 0 {
+		printutils.FromFlags(flags).PrintOrDie(results)
+		log.Warn().Msgf("follower indices of all %d leader indices already exist", len(leaderIndices))
+		return
+	}
+	log.Info().Msgf("found %d %s to replicate for %s expression [%d skipped]:\n%s",
+		len(leaders), fp.Ternary("index", "indices", len(leaders) == 1), pattern, len(results), strings.Join(plan, "\n"))
+	if !flagutils.GetBoolFlag(flags, ConfirmFlag) &&
+		!prompts.IsOk(
+			prompts.QuestionPrompt(
+				fmt.Sprintf("[context:%s]Are you sure you want to start replication of %d %s?",
+					client.Config.Current, len(leaders), fp.Ternary("index", "indices", len(leaders) == 1)))) {
+		return
+	}
+	followerIndices := make([]string, 0, len(leaders))
+	for follower := range leaders {
+		followerIndices = append(followerIndices, follower)
+	}
+	sort.Strings(followerIndices)
+	started := runParallel(followerIndices, "start", flagutils.GetIntFlag(flags, ConcurrencyFlag), func(follower string) error {
+		opts := replication.StartReplicationReq{Index: follower, Body: body}
+		opts.Body.LeaderIndex = leaders[follower]
+		rsp, err := client.CreateReplication(opts)
+		if err == nil && !rsp.Acknowledged {
+			err = errors.New("replication start is not acknowledged")
+		}
+		return err
+	})
+	for i := range started {
+		if started[i].Status == indices.ResultDone {
+			started[i].Details = fmt.Sprintf("from %s:%s", body.LeaderAlias, leaders[started[i].Index])
+		}
+	}
+	results = append(results, started...)
+	slices.SortStableFunc(results, func(a, b indices.OperationResult) int {
+		return strings.Compare(a.Index, b.Index)
+	})
+	printutils.FromFlags(flags).PrintOrDie(results)
+	if failed := results.Count(indices.ResultFailed); failed > 0 {
+		log.Fatal().Msgf("fail to start replication of %d of %d indices", failed, len(leaders))
+	}
+}
+
+// listLeaderIndices returns the sorted names of the leader indices compliant with the pattern, listed with
+// the '--leader-context' context if set, otherwise through the remote cluster connection of the follower cluster.
+// The hidden indices are listed only if the pattern starts with '.'.
+func listLeaderIndices(cmd *cobra.Command, client *api.OpensearchWrapper, leaderAlias, pattern string) []string {
+	var names []string
+	if leaderContext := flagutils.GetStringFlag(cmd.Flags(), LeaderContextFlag); leaderContext != "" {
+		registeredIndices, err := api.NewForContext(cmd, leaderContext).GetIndexList()
+		if err != nil {
+			log.Fatal().Msgf("failed to get indices of the leader context '%s':%v", leaderContext, err)
+		}
+		names = fp.Filter(
+			fp.Map(registeredIndices, func(info api.IndexInfo) string { return info.Index }),
+			gu.GetMatchFunc(pattern))
+		sort.Strings(names)
+	} else {
+		var err error
+		if names, err = client.ListRemoteIndices(leaderAlias, pattern); err != nil {
+			log.Fatal().Msgf("failed to get indices of the remote cluster '%s':%v", leaderAlias, err)
+		}
+	}
+	if strings.HasPrefix(pattern, ".") {
+		return names
+	}
+	return fp.Filter(names, func(name string) bool { return !strings.HasPrefix(name, ".") })
 }
 
 func init() {
 	replicationCreateCmd.PersistentFlags().String(IndexNameFlag, "", "name of the index to create replication")
 	replicationCreateCmd.PersistentFlags().String(LeaderAliasFlag, "", "leader alias")
-	replicationCreateCmd.PersistentFlags().String(LeaderIndexFlag, "", "leader index")
+	replicationCreateCmd.PersistentFlags().String(LeaderIndexFlag, "", "leader index or the leader index pattern")
 	replicationCreateCmd.PersistentFlags().String(LeaderClusterRoleFlag, "", "[mandatory if security plugin enabled]leader cluster role")
 	replicationCreateCmd.PersistentFlags().String(FollowerClusterRoleFlag, "", "[mandatory if security plugin enabled]follower cluster role")
+	replicationCreateCmd.PersistentFlags().String(LeaderContextFlag, "", "[pattern]context of the leader cluster used to list the leader indices, the remote cluster connection is used if not set")
+	replicationCreateCmd.PersistentFlags().String(FollowerPrefixFlag, "", "[pattern]prefix added to the follower index names")
+	replicationCreateCmd.PersistentFlags().String(FollowerSuffixFlag, "", "[pattern]suffix added to the follower index names")
+	replicationCreateCmd.PersistentFlags().String(RenamePatternFlag, "", "[pattern]regular expression replaced in the leader index names to get the follower index names")
+	replicationCreateCmd.PersistentFlags().String(RenameReplacementFlag, "", "[pattern]replacement of the rename pattern, can reference the groups of the pattern, e.g. '${1}'")
+	replicationCreateCmd.PersistentFlags().Int(ConcurrencyFlag, DefaultConcurrency, "[pattern]number of replication tasks created in parallel")
+	replicationCreateCmd.PersistentFlags().Bool(ConfirmFlag, false, "[pattern]start the replication without confirmation")
 }
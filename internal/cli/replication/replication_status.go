@@ -2,10 +2,10 @@ package replication
 
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	tstats "github.com/dalet-oss/opensearch-cli/pkg/api/types/stats"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
 	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
 	"github.com/spf13/cobra"
 	"sort"
@@ -18,6 +18,7 @@ var replicationStatusCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		client := api.NewFromCmd(cmd)
 		replicationIndex := ""
+		result := tstats.IndexReplicationStatsList{}
 		if len(args) == 0 || args[0] == "" {
 			log.Info().Msg("index name is required")
 			registeredIndices, indexListErr := client.GetIndexList()
@@ -30,14 +31,10 @@ var replicationStatusCmd = &cobra.Command{
 			sort.Strings(indexNames)
 			replicationIndex = prompts.SelectivePrompt("Select index for query", indexNames)
 			log.Info().Msgf("selected index: %s", replicationIndex)
-			if _, err := client.StatusReplication(replicationIndex, flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
-				log.Fatal().Err(err)
-			}
+			result = append(result, queryReplicationStatus(client, replicationIndex))
 		} else if !gu.ContainsWildcard(args[0]) {
 			replicationIndex = args[0]
-			if _, err := client.StatusReplication(replicationIndex, flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
-				log.Fatal().Err(err)
-			}
+			result = append(result, queryReplicationStatus(client, replicationIndex))
 		} else {
 			registeredIndices, indexListErr := client.GetIndexList()
 			if indexListErr != nil {
@@ -54,11 +51,22 @@ var replicationStatusCmd = &cobra.Command{
 					"found %d %s for %s expression",
 					len(filtered), fp.Ternary("index", "indices", len(filtered) == 1), args[0])
 				for _, index := range filtered {
-					if _, err := client.StatusReplication(index, flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
-						log.Fatal().Err(err)
-					}
+					result = append(result, queryReplicationStatus(client, index))
 				}
 			}
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
 	},
 }
+
+// queryReplicationStatus returns the replication status of the index.
+func queryReplicationStatus(client *api.OpensearchWrapper, index string) tstats.IndexReplicationStatsResponse {
+	status, err := client.StatusReplication(index)
+	if err != nil {
+		log.Fatal().Msgf("failed to get replication status for index '%s':%v", index, err)
+	}
+	if status.FollowerIndex == "" {
+		status.FollowerIndex = index
+	}
+	return status
+}
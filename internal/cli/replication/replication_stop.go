@@ -1,63 +1,26 @@
 package replication
 
 import (
+	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
 	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
 	"github.com/spf13/cobra"
-	"sort"
 )
 
 var replicationStopCmd = &cobra.Command{
-	Use:     "stop",
-	Short:   "stops replication.",
+	Use:   "stop",
+	Short: "⚠️stops replication.",
+	Long: fmt.Sprintf(`
+Stop the replication of the index or of the indices compliant with the pattern, the follower index becomes
+the regular index and the replication can't be resumed. The pattern is confirmed with the prompt listing
+the indices unless '--approve' is set.
+%s`, gu.WildHelp),
 	Example: `opensearch-cli replication stop [INDEX NAME | index pattern]`,
 	Run: func(cmd *cobra.Command, args []string) {
-		client := api.NewFromCmd(cmd)
-		replicationIndex := ""
-		if len(args) == 0 || args[0] == "" {
-			log.Info().Msg("index name is required")
-			registeredIndices, indexListErr := client.GetIndexList()
-			if indexListErr != nil {
-				log.Fatal().Err(indexListErr)
-			}
-			indexNames := fp.Map(registeredIndices, func(info api.IndexInfo) string {
-				return info.Index
-			})
-			sort.Strings(indexNames)
-			replicationIndex = prompts.SelectivePrompt("Select index for query", indexNames)
-			log.Info().Msgf("selected index: %s", replicationIndex)
-			if err := client.StopReplication(replicationIndex, flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
-				log.Fatal().Err(err)
-			}
-		} else if !gu.ContainsWildcard(args[0]) {
-			replicationIndex = args[0]
-			if err := client.StopReplication(replicationIndex, flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
-				log.Fatal().Err(err)
-			}
-		} else {
-			registeredIndices, indexListErr := client.GetIndexList()
-			if indexListErr != nil {
-				log.Fatal().Err(indexListErr)
-			}
-			indexNames := fp.Map(registeredIndices, func(info api.IndexInfo) string { return info.Index })
-			filtered := fp.Filter(indexNames, gu.GetMatchFunc(args[0]))
-			sort.Strings(filtered)
-			if len(filtered) == 0 {
-				log.Warn().Msgf("no indices found for %s expression [total %d in the cluster]", args[0], len(indexNames))
-				return
-			} else {
-				log.Info().Msgf("found %d indices for '%s' expression", len(filtered), args[0])
-				for _, index := range filtered {
-					log.Info().Msgf("stopping replication for index '%s'", index)
-					if err := client.StopReplication(index, flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)); err != nil {
-						log.Fatal().Err(err)
-					}
-				}
-			}
-		}
+		runReplicationOperation(cmd, args, "stop", (*api.OpensearchWrapper).StopReplication)
 	},
 }
+
+func init() {
+	addBulkFlags(replicationStopCmd)
+}
@@ -2,10 +2,11 @@ package replication
 
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
-	"github.com/dalet-oss/opensearch-cli/pkg/consts"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/replication"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
 	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
 	"github.com/spf13/cobra"
 	"sort"
@@ -23,7 +24,7 @@ var replicationTaskStatusCmd = &cobra.Command{
 		client := api.NewFromCmd(cmd)
 		replicationIndex := ""
 		detailed := flagutils.GetBoolFlag(cmd.Flags(), DetailedFlag)
-		raw := flagutils.GetBoolFlag(cmd.Flags(), consts.RawFlag)
+		result := replication.RecoveryStatusResponse{}
 		if len(args) == 0 || args[0] == "" {
 			log.Info().Msg("index name is required")
 			registeredIndices, indexListErr := client.GetIndexList()
@@ -36,14 +37,10 @@ var replicationTaskStatusCmd = &cobra.Command{
 			sort.Strings(indexNames)
 			replicationIndex = prompts.SelectivePrompt("Select index for query", indexNames)
 			log.Info().Msgf("selected index: %s", replicationIndex)
-			if err := client.TaskStatusReplication(replicationIndex, detailed, raw); err != nil {
-				log.Fatal().Err(err)
-			}
+			result = append(result, queryTaskStatus(client, replicationIndex, detailed)...)
 		} else if !gu.ContainsWildcard(args[0]) {
 			replicationIndex = args[0]
-			if err := client.TaskStatusReplication(replicationIndex, detailed, raw); err != nil {
-				log.Fatal().Err(err)
-			}
+			result = append(result, queryTaskStatus(client, replicationIndex, detailed)...)
 		} else {
 			registeredIndices, indexListErr := client.GetIndexList()
 			if indexListErr != nil {
@@ -59,15 +56,23 @@ var replicationTaskStatusCmd = &cobra.Command{
 				log.Info().Msgf("found %d indices for '%s' expression", len(filtered), args[0])
 				for _, index := range filtered {
 					log.Info().Msgf("querying replication status for the index '%s'", index)
-					if err := client.TaskStatusReplication(index, detailed, raw); err != nil {
-						log.Fatal().Err(err)
-					}
+					result = append(result, queryTaskStatus(client, index, detailed)...)
 				}
 			}
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
 	},
 }
 
 func init() {
 	replicationTaskStatusCmd.PersistentFlags().Bool(DetailedFlag, false, "show detailed info about tasks.")
 }
+
+// queryTaskStatus returns the recovery status of the index shards.
+func queryTaskStatus(client *api.OpensearchWrapper, index string, detailed bool) replication.RecoveryStatusResponse {
+	status, err := client.TaskStatusReplication(index, detailed)
+	if err != nil {
+		log.Fatal().Msgf("failed to get replication task status for index '%s':%v", index, err)
+	}
+	return status
+}
@@ -0,0 +1,86 @@
+package replication
+
+import (
+	"errors"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunParallel(t *testing.T) {
+	tests := []struct {
+		name        string
+		indexNames  []string
+		concurrency int
+		failing     map[string]bool
+		expected    indices.OperationResults
+	}{
+		{
+			name:        "all operations succeed",
+			indexNames:  []string{"c", "a", "b"},
+			concurrency: 2,
+			expected: indices.OperationResults{
+				{Index: "a", Operation: "pause", Status: indices.ResultDone},
+				{Index: "b", Operation: "pause", Status: indices.ResultDone},
+				{Index: "c", Operation: "pause", Status: indices.ResultDone},
+			},
+		},
+		{
+			name:        "failed operation is reported with the error",
+			indexNames:  []string{"b", "a"},
+			concurrency: 4,
+			failing:     map[string]bool{"b": true},
+			expected: indices.OperationResults{
+				{Index: "a", Operation: "pause", Status: indices.ResultDone},
+				{Index: "b", Operation: "pause", Status: indices.ResultFailed, Details: "index b failed"},
+			},
+		},
+		{
+			name:        "default concurrency if it isn't positive",
+			indexNames:  []string{"a"},
+			concurrency: 0,
+			expected: indices.OperationResults{
+				{Index: "a", Operation: "pause", Status: indices.ResultDone},
+			},
+		},
+		{
+			name:       "no indices",
+			indexNames: nil,
+			expected:   indices.OperationResults{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := runParallel(tt.indexNames, "pause", tt.concurrency, func(index string) error {
+				if tt.failing[index] {
+					return errors.New("index " + index + " failed")
+				}
+				return nil
+			})
+			assert.Equal(t, tt.expected, results)
+		})
+	}
+}
+
+func TestRunParallel_Concurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	indexNames := []string{"a", "b", "c", "d", "e", "f"}
+	results := runParallel(indexNames, "resume", 3, func(index string) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			observed := peak.Load()
+			if current <= observed || peak.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+	assert.Len(t, results, len(indexNames))
+	assert.Equal(t, len(indexNames), results.Count(indices.ResultDone))
+	assert.LessOrEqual(t, peak.Load(), int32(3), "no more operations than the concurrency are expected to run at once")
+	assert.Greater(t, peak.Load(), int32(1), "operations are expected to run in parallel")
+}
@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/spf13/cobra"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+)
+
+var log = logging.Logger()
+
+const (
+	DataFlag     = "data"
+	DataFileFlag = "data-file"
+	QueryFlag    = "query"
+	HeaderFlag   = "header"
+	FailFlag     = "fail"
+	IncludeFlag  = "include"
+)
+
+// Methods holds the list of supported HTTP methods.
+var Methods = []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+func NewRestCmd() *cobra.Command {
+	return restCmd
+}
+
+// restCmd represents the api command
+var restCmd = &cobra.Command{
+	Use:   "api [METHOD] PATH",
+	Short: "sends an arbitrary REST request to the cluster.",
+	Long: `
+Send an arbitrary REST request to the cluster of the active context, the credentials and TLS settings of the context are used.
+The method defaults to GET, the JSON responses are pretty-printed.
+The request body is taken from '--data', or from the file given by '--data-file'('-' reads stdin).
+With '--fail' the command exits with code 4 on 4xx and 5 on 5xx(and other non-2xx) responses.
+`,
+	Example: `
+opensearch-cli api /_cat/shards -q v -q s=store:desc
+opensearch-cli api GET /_cluster/health --fail
+opensearch-cli api PUT /my-index/_settings -d '{"index":{"number_of_replicas":2}}'
+cat bulk.ndjson | opensearch-cli api POST /_bulk --data-file -
+`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		method, path := http.MethodGet, args[0]
+		if len(args) == 2 {
+			method, path = strings.ToUpper(args[0]), args[1]
+		}
+		if !slices.Contains(Methods, method) {
+			log.Fatal().Msgf("unsupported method '%s', available methods are: %s", method, strings.Join(Methods, ","))
+		}
+		query, err := parseQuery(flagutils.GetStringArrayFlag(cmd.Flags(), QueryFlag))
+		if err != nil {
+			log.Fatal().Msgf("invalid query:%v", err)
+		}
+		header, err := parseHeader(flagutils.GetStringArrayFlag(cmd.Flags(), HeaderFlag))
+		if err != nil {
+			log.Fatal().Msgf("invalid header:%v", err)
+		}
+		body, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal().Msgf("unable to read request body:%v", err)
+		}
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+			if header.Get("Content-Type") == "" {
+				header.Set("Content-Type", contentType(path))
+			}
+		}
+
+		client := api.NewFromCmd(cmd)
+		rsp, err := client.Rest(method, path, query, header, bodyReader)
+		if err != nil {
+			log.Fatal().Msgf("request failed:%v", err)
+		}
+		if flagutils.GetBoolFlag(cmd.Flags(), IncludeFlag) {
+			fmt.Println(rsp.Status)
+			for _, k := range slices.Sorted(maps.Keys(rsp.Header)) {
+				fmt.Printf("%s: %s\n", k, strings.Join(rsp.Header.Values(k), ", "))
+			}
+			fmt.Println()
+		}
+		printBody(os.Stdout, rsp.Body)
+		if !rsp.IsSuccess() && flagutils.GetBoolFlag(cmd.Flags(), FailFlag) {
+			log.Error().Msgf("request failed with status %s", rsp.Status)
+			os.Exit(exitCode(rsp.StatusCode))
+		}
+	},
+}
+
+func init() {
+	restCmd.Flags().StringP(DataFlag, "d", "", "request body.")
+	restCmd.Flags().StringP(DataFileFlag, "f", "", "file with the request body, '-' reads stdin.")
+	restCmd.Flags().StringArrayP(QueryFlag, "q", nil, "query parameter in the form key=value or key, repeatable.")
+	restCmd.Flags().StringArrayP(HeaderFlag, "H", nil, "request header in the form 'Key: Value', repeatable.")
+	restCmd.Flags().Bool(FailFlag, false, "exit with non-zero code on non-2xx response.")
+	restCmd.Flags().BoolP(IncludeFlag, "i", false, "print the response status and headers.")
+	restCmd.MarkFlagsMutuallyExclusive(DataFlag, DataFileFlag)
+}
+
+// parseQuery converts the key=value pairs to the query values, the key without value is set to the empty string.
+func parseQuery(pairs []string) (url.Values, error) {
+	query := url.Values{}
+	for _, pair := range pairs {
+		k, v, _ := strings.Cut(pair, "=")
+		if k == "" {
+			return nil, fmt.Errorf("query parameter '%s' has no key", pair)
+		}
+		query.Add(k, v)
+	}
+	return query, nil
+}
+
+// parseHeader converts the 'Key: Value' pairs to the request header.
+func parseHeader(pairs []string) (http.Header, error) {
+	header := http.Header{}
+	for _, pair := range pairs {
+		k, v, found := strings.Cut(pair, ":")
+		if !found || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("header '%s' must be in the form 'Key: Value'", pair)
+		}
+		header.Add(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	return header, nil
+}
+
+// contentType returns the content type of the request body, the bulk-like endpoints expect newline delimited JSON.
+func contentType(path string) string {
+	endpoint, _, _ := strings.Cut(path, "?")
+	for _, ndjson := range []string{"_bulk", "_msearch", "_mtermvectors"} {
+		if strings.HasSuffix(endpoint, ndjson) {
+			return "application/x-ndjson"
+		}
+	}
+	return "application/json"
+}
+
+// printBody writes the response body, the JSON body is pretty-printed and the rest is written as is.
+func printBody(out io.Writer, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	var pretty bytes.Buffer
+	if json.Valid(body) && json.Indent(&pretty, body, "", "    ") == nil {
+		body = pretty.Bytes()
+	}
+	_, _ = out.Write(body)
+	if body[len(body)-1] != '\n' {
+		_, _ = fmt.Fprintln(out)
+	}
+}
+
+// exitCode maps the response status to the exit code of the command, 4 for 4xx and 5 for the other failures.
+func exitCode(status int) int {
+	if status >= http.StatusBadRequest && status < http.StatusInternalServerError {
+		return 4
+	}
+	return 5
+}
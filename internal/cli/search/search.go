@@ -0,0 +1,131 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/search"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var log = logging.Logger()
+
+const (
+	QueryFlag    = "query"
+	BodyFlag     = "body"
+	FieldsFlag   = "fields"
+	SortFlag     = "sort"
+	SizeFlag     = "size"
+	AllFlag      = "all"
+	PageSizeFlag = "page-size"
+	PaginateFlag = "paginate"
+)
+
+func NewSearchCmd() *cobra.Command {
+	return searchCmd
+}
+
+// searchCmd represents the search command
+var searchCmd = &cobra.Command{
+	Use:   "search <index|pattern>",
+	Short: "searches the documents of the indices.",
+	Long: fmt.Sprintf(`
+Search the documents of the index, the comma separated list of indices or the indices compliant with the pattern.
+The query is either the Lucene query string('-q') or the Query DSL body read from the file('--body', '-' reads stdin),
+'--fields', '--sort' and '-q' override the corresponding parts of the body.
+The hits which don't fit in the single page('--size' above '--page-size' or '--all') are streamed with the scroll,
+or with search_after on the point in time('--paginate pit', the sort must end with the unique field).
+Use '-o ndjson' to pipe the hits, they are printed as soon as the page is received.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli search logs-* -q 'level:ERROR AND service:api' --fields @timestamp,message --sort @timestamp:desc
+opensearch-cli search orders --body query.json --size 100 -o json
+opensearch-cli search orders --all -o ndjson > orders.ndjson
+opensearch-cli search orders --all --paginate pit --sort order_id -o ndjson
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := search.Options{
+			Query:    flagutils.GetStringFlag(cmd.Flags(), QueryFlag),
+			Fields:   flagutils.GetStringSliceFlag(cmd.Flags(), FieldsFlag),
+			Sort:     flagutils.GetStringSliceFlag(cmd.Flags(), SortFlag),
+			Size:     flagutils.GetIntFlag(cmd.Flags(), SizeFlag),
+			PageSize: flagutils.GetIntFlag(cmd.Flags(), PageSizeFlag),
+			Paginate: flagutils.GetStringFlagInSet(cmd.Flags(), PaginateFlag, search.PaginateModes),
+		}
+		if flagutils.GetBoolFlag(cmd.Flags(), AllFlag) {
+			opts.Size = -1
+		} else if opts.Size < 0 {
+			log.Fatal().Msgf("flag '--%s' must not be negative, use '--%s' to return all hits", SizeFlag, AllFlag)
+		}
+		if flagutils.GetStringFlag(cmd.Flags(), BodyFlag) != "" {
+			body, err := readBody(cmd)
+			if err != nil {
+				log.Fatal().Msgf("unable to read search body:%v", err)
+			}
+			opts.Body = body
+			// the size of the body is kept unless it is overridden by the flags
+			if size, ok := opts.BodySize(); ok && !cmd.Flags().Changed(SizeFlag) && !flagutils.GetBoolFlag(cmd.Flags(), AllFlag) {
+				opts.Size = size
+			}
+		}
+
+		client := api.NewFromCmd(cmd)
+		indices, err := client.ResolveIndices(args[0])
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		printer := printutils.FromFlags(cmd.Flags())
+		var hits []search.Hit
+		summary, err := client.Search(indices, opts, func(hit search.Hit) error {
+			if printer.Format == printutils.FormatNDJSON {
+				return printer.Print(hit)
+			}
+			hits = append(hits, hit)
+			return nil
+		})
+		if err != nil {
+			log.Fatal().Msgf("search failed:%v", err)
+		}
+		switch printer.Format {
+		case printutils.FormatNDJSON:
+		case printutils.FormatJSON, printutils.FormatYAML:
+			printer.PrintOrDie(hits)
+		default:
+			printer.PrintOrDie(search.HitsTable{Fields: opts.Fields, Hits: hits})
+		}
+		log.Info().Msgf("%s in %s", summary, strings.Join(indices, ","))
+	},
+}
+
+func init() {
+	searchCmd.Flags().StringP(QueryFlag, "q", "", "Lucene query string.")
+	searchCmd.Flags().StringP(BodyFlag, "b", "", "file with the Query DSL body, '-' reads stdin.")
+	searchCmd.Flags().StringSlice(FieldsFlag, nil, "comma separated list of the returned source fields.")
+	searchCmd.Flags().StringSlice(SortFlag, nil, "comma separated list of the sort fields in the form field[:asc|desc].")
+	searchCmd.Flags().Int(SizeFlag, 10, "maximum number of the returned hits, the size of the '--body' is used if not set.")
+	searchCmd.Flags().Bool(AllFlag, false, "return all hits.")
+	searchCmd.Flags().Int(PageSizeFlag, search.DefaultPageSize, "number of hits fetched by the single request when paginating.")
+	searchCmd.Flags().String(PaginateFlag, search.PaginateScroll,
+		fmt.Sprintf("pagination mode, one of: %s", strings.Join(search.PaginateModes, "|")))
+	searchCmd.MarkFlagsMutuallyExclusive(SizeFlag, AllFlag)
+}
+
+// readBody reads the JSON body from the file of the body flag, '-' reads stdin.
+func readBody(cmd *cobra.Command) (map[string]any, error) {
+	content, err := flagutils.GetFileFlag(cmd.Flags(), BodyFlag, cmd.InOrStdin())
+	if err != nil {
+		return nil, err
+	}
+	var body map[string]any
+	if err := json.Unmarshal(content, &body); err != nil {
+		return nil, fmt.Errorf("body is not a valid JSON object:%w", err)
+	}
+	return body, nil
+}
@@ -0,0 +1,71 @@
+package security
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/security"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/spf13/cobra"
+)
+
+var log = logging.Logger()
+
+const (
+	ConfirmFlag       = "approve"
+	DataFlag          = "data"
+	DataFileFlag      = "data-file"
+	LeaderContextFlag = "leader-context"
+	LeaderRoleFlag    = "leader-role"
+	FollowerRoleFlag  = "follower-role"
+	IndexPatternFlag  = "index-pattern"
+	UserFlag          = "user"
+)
+
+func NewSecurityCmd() *cobra.Command {
+	// subcommands
+	for _, kind := range security.Kinds {
+		securityCmd.AddCommand(newKindCmd(kind))
+	}
+	securityCmd.AddCommand(
+		securityAuthInfoCmd,
+		securityCCRRolesCmd,
+	)
+	securityCCRRolesCmd.AddCommand(
+		securityCCRRolesBootstrapCmd,
+	)
+	return securityCmd
+}
+
+// securityCmd represents the security command
+var securityCmd = &cobra.Command{
+	Use:     "security",
+	Aliases: []string{"sec"},
+	Short:   "security plugin commands",
+	Long:    `Set of commands for the security plugin administration: internal users, roles, role mappings and action groups`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.HasAvailableSubCommands() {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		}
+	},
+}
+
+// newKindCmd returns the command group managing the security resources of the kind.
+func newKindCmd(kind security.Kind) *cobra.Command {
+	kindCmd := &cobra.Command{
+		Use:   string(kind),
+		Short: string(kind) + " commands",
+		Long:  `Set of commands for the ` + string(kind) + ` management with the REST API of the security plugin`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		},
+	}
+	kindCmd.AddCommand(
+		newListCmd(kind),
+		newGetCmd(kind),
+		newPutCmd(kind),
+		newDeleteCmd(kind),
+	)
+	return kindCmd
+}
@@ -0,0 +1,29 @@
+package security
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var securityAuthInfoCmd = &cobra.Command{
+	Use:     "authinfo",
+	Aliases: []string{"whoami"},
+	Short:   "shows the authenticated user.",
+	Long: `
+Show the user the requests of the current context are authenticated as, its backend roles,
+the security roles mapped to it and the tenants it can access.
+`,
+	Example: `
+opensearch-cli security whoami
+opensearch-cli security authinfo --context prod -o json
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		info, err := api.NewFromCmd(cmd).AuthInfo()
+		if err != nil {
+			log.Fatal().Msgf("failed to get auth info:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(info)
+	},
+}
@@ -0,0 +1,105 @@
+package security
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/security"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var securityCCRRolesCmd = &cobra.Command{
+	Use:   "ccr-roles",
+	Short: "cross-cluster replication roles commands",
+	Long:  `Set of commands for the roles used by the cross-cluster replication on the secured clusters`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			log.Err(err).Msg("failed to show help")
+		}
+	},
+}
+
+var securityCCRRolesBootstrapCmd = &cobra.Command{
+	Use:   "bootstrap",
+	Short: "creates the leader and follower replication roles.",
+	Long: `
+Create the roles the cross-cluster replication runs its tasks with on the secured clusters, with the permissions
+prescribed by the replication plugin docs: the leader role on the leader cluster given by '--leader-context',
+and the follower role on the cluster of the current context. The leader role is created on the current cluster
+too if '--leader-context' is not set, e.g. when the command is run against each cluster.
+The existing roles are replaced. The '--user' replication users are added to the mapping of the leader role
+on the leader cluster and of the follower role on the follower cluster, the users which are already mapped are kept.
+The role names are passed to 'replication create' and 'autofollow create' afterward.
+`,
+	Example: `
+opensearch-cli security ccr-roles bootstrap --leader-context leader --user replicator
+opensearch-cli security ccr-roles bootstrap --leader-context leader --index-pattern 'orders-*' --user replicator --approve
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		leaderRole := flagutils.GetNotEmptyStringFlag(cmd.Flags(), LeaderRoleFlag)
+		followerRole := flagutils.GetNotEmptyStringFlag(cmd.Flags(), FollowerRoleFlag)
+		indexPatterns := flagutils.GetStringSliceFlag(cmd.Flags(), IndexPatternFlag)
+		users := flagutils.GetStringSliceFlag(cmd.Flags(), UserFlag)
+		follower := api.NewFromCmd(cmd)
+		leader, clients := follower, []*api.OpensearchWrapper{follower}
+		if leaderContext := flagutils.GetStringFlag(cmd.Flags(), LeaderContextFlag); leaderContext != "" {
+			leader = api.NewForContext(cmd, leaderContext)
+			clients = append(clients, leader)
+		}
+		for _, client := range clients {
+			plugins, err := client.PluginsList()
+			if err != nil {
+				log.Fatal().Msgf("fail to get plugin list of context '%s':%v", client.Config.Current, err)
+			} else if !api.HasPlugin(plugins, api.SecurityPlugin) {
+				log.Fatal().Msgf("security plugin is not installed on the cluster of context '%s', the replication doesn't need the roles", client.Config.Current)
+			}
+		}
+		log.Info().Msgf("roles to create for %s indices:\nleader role '%s' on context '%s'\nfollower role '%s' on context '%s'",
+			strings.Join(indexPatterns, ","), leaderRole, leader.Config.Current, followerRole, follower.Config.Current)
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to create or replace the replication roles?", follower.Config.Current))) {
+			return
+		}
+		putRole(leader, leaderRole, security.CCRLeaderRole(indexPatterns))
+		putRole(follower, followerRole, security.CCRFollowerRole(indexPatterns))
+		if len(users) == 0 {
+			log.Warn().Msgf("no '--%s' given, map the roles to the replication user with 'security role-mapping put'", UserFlag)
+		} else {
+			mapRole(leader, leaderRole, users)
+			mapRole(follower, followerRole, users)
+		}
+		log.Info().Msgf("create the replication with 'replication create --cluster-role %[1]s --follower-cluster-role %[2]s' "+
+			"or 'autofollow create --leader-cluster-role %[1]s --follower-cluster-role %[2]s'", leaderRole, followerRole)
+	},
+}
+
+// putRole creates or replaces the role or terminates the program.
+func putRole(client *api.OpensearchWrapper, name string, role security.Role) {
+	if err := client.PutSecurityResource(security.KindRole, name, printutils.MarshalJSONOrDie(role)); err != nil {
+		log.Fatal().Msgf("failed to create role '%s' on context '%s':%v", name, client.Config.Current, err)
+	}
+	log.Info().Msgf("✅role '%s' created on context '%s'", name, client.Config.Current)
+}
+
+// mapRole adds the users to the mapping of the role or terminates the program.
+func mapRole(client *api.OpensearchWrapper, role string, users []string) {
+	if err := client.MapRoleUsers(role, users); err != nil {
+		log.Fatal().Msgf("failed to map role '%s' on context '%s':%v", role, client.Config.Current, err)
+	}
+	log.Info().Msgf("✅role '%s' mapped to %s on context '%s'", role, strings.Join(users, ","), client.Config.Current)
+}
+
+func init() {
+	securityCCRRolesBootstrapCmd.Flags().String(LeaderContextFlag, "", "context of the leader cluster, the current context by default.")
+	securityCCRRolesBootstrapCmd.Flags().String(LeaderRoleFlag, "ccr_leader_role", "name of the leader role.")
+	securityCCRRolesBootstrapCmd.Flags().String(FollowerRoleFlag, "ccr_follower_role", "name of the follower role.")
+	securityCCRRolesBootstrapCmd.Flags().StringSlice(IndexPatternFlag, []string{"*"}, "comma separated patterns of the replicated indices.")
+	securityCCRRolesBootstrapCmd.Flags().StringSlice(UserFlag, nil, "comma separated replication users to map the roles to.")
+	securityCCRRolesBootstrapCmd.Flags().Bool(ConfirmFlag, false, "create the roles without confirmation")
+}
@@ -0,0 +1,129 @@
+package security
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/security"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(kind security.Kind) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list [pattern]",
+		Aliases: []string{"ls"},
+		Short:   fmt.Sprintf("lists %ss.", kind),
+		Long: fmt.Sprintf(`
+List the %ss, only the ones with the name compliant with the pattern if it is set.
+The hidden resources are not returned by the security plugin.
+%s
+`, kind, gu.WildHelp),
+		Example: fmt.Sprintf(`
+opensearch-cli security %[1]s list
+opensearch-cli security %[1]s list 'app*' -o wide
+`, kind),
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pattern := ""
+			if len(args) == 1 {
+				pattern = args[0]
+			}
+			list, err := api.NewFromCmd(cmd).GetSecurityResources(kind, pattern)
+			if err != nil {
+				log.Fatal().Msgf("failed to get %ss:%v", kind, err)
+			}
+			printutils.FromFlags(cmd.Flags()).PrintOrDie(list)
+		},
+	}
+}
+
+func newGetCmd(kind security.Kind) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <name>",
+		Short: fmt.Sprintf("shows the %s definition.", kind),
+		Long:  fmt.Sprintf(`Show the definition of the %s, the output can be used as the body of the put command.`, kind),
+		Example: fmt.Sprintf(`
+opensearch-cli security %[1]s get app > app.json
+opensearch-cli security %[1]s get app -o yaml
+`, kind),
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			definition, err := api.NewFromCmd(cmd).GetSecurityResource(kind, args[0])
+			if err != nil {
+				log.Fatal().Msgf("failed to get %s:%v", kind, err)
+			} else if definition == nil {
+				log.Fatal().Msgf("%s '%s' not found", kind, args[0])
+			}
+			printutils.FromFlags(cmd.Flags()).PrintOrDie(definition)
+		},
+	}
+}
+
+func newPutCmd(kind security.Kind) *cobra.Command {
+	putCmd := &cobra.Command{
+		Use:   "put <name>",
+		Short: fmt.Sprintf("creates or replaces the %s.", kind),
+		Long: fmt.Sprintf(`
+Create or replace the %s with the definition from '--data', or from the file given by '--data-file'('-' reads stdin).
+The definition can also be the output of the get command, the read-only fields(reserved, hidden, static) are dropped.
+The reserved resources can't be changed.
+`, kind),
+		Example: fmt.Sprintf(`
+opensearch-cli security %[1]s put app -f app.json
+opensearch-cli security %[1]s get app -o json | opensearch-cli security %[1]s put app-copy -f - --context other
+`, kind),
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			data, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+			if err != nil {
+				log.Fatal().Msgf("unable to read %s definition:%v", kind, err)
+			} else if data == nil {
+				log.Fatal().Msgf("%s definition is required, use '--%s' or '--%s'", kind, DataFlag, DataFileFlag)
+			}
+			definition, err := security.Definition(args[0], data)
+			if err != nil {
+				log.Fatal().Msgf("%v", err)
+			}
+			if err := api.NewFromCmd(cmd).PutSecurityResource(kind, args[0], definition); err != nil {
+				log.Fatal().Msgf("failed to put %s:%v", kind, err)
+			}
+			log.Info().Msgf("%s '%s' saved", kind, args[0])
+		},
+	}
+	putCmd.Flags().StringP(DataFlag, "d", "", fmt.Sprintf("%s definition.", kind))
+	putCmd.Flags().StringP(DataFileFlag, "f", "", fmt.Sprintf("file with the %s definition, '-' reads stdin.", kind))
+	putCmd.MarkFlagsMutuallyExclusive(DataFlag, DataFileFlag)
+	return putCmd
+}
+
+func newDeleteCmd(kind security.Kind) *cobra.Command {
+	deleteCmd := &cobra.Command{
+		Use:     "delete <name>",
+		Aliases: []string{"rm"},
+		Short:   fmt.Sprintf("⚠️deletes the %s.", kind),
+		Long:    fmt.Sprintf(`Delete the %s, the reserved resources can't be deleted.`, kind),
+		Example: fmt.Sprintf(`
+opensearch-cli security %[1]s delete app
+opensearch-cli security %[1]s delete app --approve
+`, kind),
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client := api.NewFromCmd(cmd)
+			if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+				!prompts.IsOk(
+					prompts.QuestionPrompt(
+						fmt.Sprintf("[context:%s]Are you sure you want to delete %s '%s'?", client.Config.Current, kind, args[0]))) {
+				return
+			}
+			if err := client.DeleteSecurityResource(kind, args[0]); err != nil {
+				log.Fatal().Msgf("failed to delete %s:%v", kind, err)
+			}
+			log.Info().Msgf("%s '%s' deleted", kind, args[0])
+		},
+	}
+	deleteCmd.Flags().Bool(ConfirmFlag, false, fmt.Sprintf("delete the %s without confirmation", kind))
+	return deleteCmd
+}
@@ -0,0 +1,128 @@
+package snapshot
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/cluster"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/snapshots"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+	"strings"
+	"time"
+)
+
+var log = logging.Logger()
+
+// pollInterval is the interval between the status requests while the snapshot or the restore is watched.
+const pollInterval = 2 * time.Second
+
+const (
+	ConfirmFlag            = "approve"
+	TypeFlag               = "type"
+	LocationFlag           = "location"
+	BucketFlag             = "bucket"
+	BasePathFlag           = "base-path"
+	SettingFlag            = "setting"
+	NoVerifyFlag           = "no-verify"
+	IndicesFlag            = "indices"
+	IncludeGlobalStateFlag = "include-global-state"
+	PartialFlag            = "partial"
+	DetachFlag             = "detach"
+	RenamePatternFlag      = "rename-pattern"
+	RenameReplacementFlag  = "rename-replacement"
+	IncludeAliasesFlag     = "include-aliases"
+	IndexSettingFlag       = "index-setting"
+	TimeoutFlag            = "timeout"
+)
+
+func NewSnapshotCmd() *cobra.Command {
+	// subcommands
+	snapshotCmd.AddCommand(
+		snapshotRepoCmd,
+		snapshotCreateCmd,
+		snapshotListCmd,
+		snapshotStatusCmd,
+		snapshotDeleteCmd,
+		snapshotRestoreCmd,
+	)
+	snapshotRepoCmd.AddCommand(
+		snapshotRepoListCmd,
+		snapshotRepoCreateCmd,
+		snapshotRepoVerifyCmd,
+		snapshotRepoDeleteCmd,
+	)
+	return snapshotCmd
+}
+
+// snapshotCmd represents the snapshot command
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "snapshot commands",
+	Long:  `Set of commands for snapshot repository management, snapshot creation and restore`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.HasAvailableSubCommands() {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		}
+	},
+}
+
+// snapshotNames returns the names of the snapshots of the repository matching the pattern or terminates the program.
+func snapshotNames(client *api.OpensearchWrapper, repository, pattern string) []string {
+	list, err := client.GetSnapshots(repository, pattern)
+	if err != nil {
+		log.Fatal().Msgf("failed to get snapshots:%v", err)
+	}
+	if len(list) == 0 {
+		log.Fatal().Msgf("no snapshots found for '%s' in repository '%s'", pattern, repository)
+	}
+	return list.Names()
+}
+
+// WatchSnapshot waits for the snapshot to complete rendering the shard progress, returns the snapshot.
+func WatchSnapshot(client *api.OpensearchWrapper, repository, name string) (snapshots.Snapshot, error) {
+	progress := printutils.NewProgress("snapshot", 0)
+	return client.WaitForSnapshot(repository, name, pollInterval, func(status snapshots.Status) {
+		progress.Total = int64(status.ShardsStats.Total)
+		if snapshots.IsCompleted(status.State) {
+			progress.Finish(int64(status.ShardsStats.Done), status.Details())
+		} else {
+			progress.Update(int64(status.ShardsStats.Done), status.Details())
+		}
+	})
+}
+
+// watchRestore polls the recovery of the restored indices until the expected number of the primary shards is restored,
+// it stops when the restore of any primary shard fails or the timeout elapses.
+func watchRestore(client *api.OpensearchWrapper, indices []string, primaries int64, timeout time.Duration) {
+	progress := printutils.NewProgress("restore", primaries)
+	deadline := time.Now().Add(timeout)
+	for {
+		recovery, err := client.GetRecovery(indices)
+		if err != nil {
+			log.Fatal().Msgf("failed to get recovery:%v", err)
+		}
+		done, recovered, total := recovery.RestoreProgress()
+		if done >= primaries {
+			progress.Finish(done, snapshots.RestoreDetails(recovered, total))
+			return
+		}
+		progress.Update(done, snapshots.RestoreDetails(recovered, total))
+		shards, err := client.GetShards(strings.Join(indices, ","))
+		if err != nil {
+			log.Fatal().Msgf("failed to get shards:%v", err)
+		}
+		if failed := shards.FailedPrimaries(); len(failed) > 0 {
+			names := fp.Map(failed, func(shard cluster.Shard) string { return fmt.Sprintf("%s[%d]", shard.Index, shard.Shard) })
+			log.Fatal().Msgf("restore of %d primary shards failed: %s, see 'cluster allocation-explain' for the reason",
+				len(failed), strings.Join(names, ", "))
+		}
+		if time.Now().After(deadline) {
+			log.Fatal().Msgf("%d of %d primary shards restored in %s, the restore keeps running in the background", done, primaries, timeout)
+		}
+		time.Sleep(pollInterval)
+	}
+}
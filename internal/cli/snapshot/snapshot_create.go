@@ -0,0 +1,59 @@
+package snapshot
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/snapshots"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create <repository> <snapshot>",
+	Short: "creates the snapshot of the indices.",
+	Long: `
+Start the snapshot of all indices, or of the '--indices'(the names or the patterns, '-' excludes the indices),
+in the background and watch its status, rendering the progress of the shards.
+The interrupted command doesn't stop the snapshot, use 'snapshot status' to check it.
+`,
+	Example: `
+opensearch-cli snapshot create backup nightly-2024.06.01
+opensearch-cli snapshot create backup orders-before-migration --indices 'orders-*,-orders-tmp'
+opensearch-cli snapshot create backup full --include-global-state --detach
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		repository, name := args[0], args[1]
+		client := api.NewFromCmd(cmd)
+		opts := snapshots.CreateOptions{
+			Indices:            flagutils.GetStringSliceFlag(cmd.Flags(), IndicesFlag),
+			IncludeGlobalState: flagutils.GetBoolFlag(cmd.Flags(), IncludeGlobalStateFlag),
+			Partial:            flagutils.GetBoolFlag(cmd.Flags(), PartialFlag),
+		}
+		if err := client.CreateSnapshot(repository, name, opts); err != nil {
+			log.Fatal().Msgf("failed to create snapshot:%v", err)
+		}
+		log.Info().Msgf("snapshot '%s' started in repository '%s'", name, repository)
+		if flagutils.GetBoolFlag(cmd.Flags(), DetachFlag) {
+			return
+		}
+		snapshot, err := WatchSnapshot(client, repository, name)
+		if err != nil {
+			log.Fatal().Msgf("failed to get snapshot status:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(snapshots.Snapshots{snapshot})
+		switch snapshot.State {
+		case snapshots.StateFailed:
+			log.Fatal().Msgf("snapshot '%s' failed", name)
+		case snapshots.StatePartial:
+			log.Warn().Msgf("snapshot '%s' is partial, %d shards failed", name, snapshot.Shards.Failed)
+		}
+	},
+}
+
+func init() {
+	snapshotCreateCmd.Flags().StringSlice(IndicesFlag, nil, "comma separated indices or patterns to include, all indices by default.")
+	snapshotCreateCmd.Flags().Bool(IncludeGlobalStateFlag, false, "include the cluster state, the templates and the persistent settings.")
+	snapshotCreateCmd.Flags().Bool(PartialFlag, false, "allow the snapshot of the indices with the unavailable primary shards.")
+	snapshotCreateCmd.Flags().Bool(DetachFlag, false, "don't wait for the snapshot to complete.")
+}
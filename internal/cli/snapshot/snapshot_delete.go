@@ -0,0 +1,46 @@
+package snapshot
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var snapshotDeleteCmd = &cobra.Command{
+	Use:     "delete <repository> <snapshot|pattern>",
+	Aliases: []string{"rm"},
+	Short:   "⚠️deletes the snapshots.",
+	Long: fmt.Sprintf(`
+Delete the snapshot, or all snapshots of the repository compliant with the pattern.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli snapshot delete backup nightly-2024.06.01
+opensearch-cli snapshot delete backup 'nightly-2023*' --approve
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewFromCmd(cmd)
+		names := snapshotNames(client, args[0], args[1])
+		log.Info().Msgf("snapshots to delete:\n%s", strings.Join(names, "\n"))
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to delete %d snapshots from repository '%s'?",
+						client.Config.Current, len(names), args[0]))) {
+			return
+		}
+		if err := client.DeleteSnapshots(args[0], names); err != nil {
+			log.Fatal().Msgf("failed to delete snapshots:%v", err)
+		}
+		log.Info().Msgf("%d snapshots deleted", len(names))
+	},
+}
+
+func init() {
+	snapshotDeleteCmd.Flags().Bool(ConfirmFlag, false, "delete the snapshots without confirmation")
+}
@@ -0,0 +1,55 @@
+package snapshot
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var snapshotListCmd = &cobra.Command{
+	Use:     "list <repository> [snapshot|pattern]",
+	Aliases: []string{"ls"},
+	Short:   "lists the snapshots of the repository.",
+	Long: `
+List the snapshots of the repository, or the ones matching the pattern, sorted by the start time.
+'-o wide' shows the indices of the snapshots.
+`,
+	Example: `
+opensearch-cli snapshot list backup
+opensearch-cli snapshot list backup 'nightly-*' -o wide
+`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		pattern := ""
+		if len(args) == 2 {
+			pattern = args[1]
+		}
+		list, err := api.NewFromCmd(cmd).GetSnapshots(args[0], pattern)
+		if err != nil {
+			log.Fatal().Msgf("failed to get snapshots:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(list)
+	},
+}
+
+var snapshotStatusCmd = &cobra.Command{
+	Use:   "status <repository> <snapshot|pattern>",
+	Short: "shows the detailed status of the snapshots.",
+	Long: `
+Show the shard progress and the file statistics of the snapshots matching the pattern,
+'-o wide' shows them per index.
+`,
+	Example: `
+opensearch-cli snapshot status backup nightly-2024.06.01
+opensearch-cli snapshot status backup 'nightly-*' -o wide
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewFromCmd(cmd)
+		statuses, err := client.SnapshotStatus(args[0], snapshotNames(client, args[0], args[1]))
+		if err != nil {
+			log.Fatal().Msgf("failed to get snapshot status:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(statuses)
+	},
+}
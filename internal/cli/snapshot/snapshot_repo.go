@@ -0,0 +1,138 @@
+package snapshot
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/snapshots"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var snapshotRepoCmd = &cobra.Command{
+	Use:     "repo",
+	Aliases: []string{"repository"},
+	Short:   "snapshot repository commands",
+	Long:    `Set of commands for the snapshot repository management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			log.Err(err).Msg("failed to show help")
+		}
+	},
+}
+
+var snapshotRepoListCmd = &cobra.Command{
+	Use:     "list [repository|pattern]",
+	Aliases: []string{"ls"},
+	Short:   "lists the snapshot repositories.",
+	Long:    `List the registered snapshot repositories with their type and settings.`,
+	Example: `
+opensearch-cli snapshot repo list
+opensearch-cli snapshot repo list 'backup-*' -o json
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		pattern := ""
+		if len(args) == 1 {
+			pattern = args[0]
+		}
+		repositories, err := api.NewFromCmd(cmd).GetRepositories(pattern)
+		if err != nil {
+			log.Fatal().Msgf("failed to get snapshot repositories:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(repositories)
+	},
+}
+
+var snapshotRepoCreateCmd = &cobra.Command{
+	Use:   "create <repository>",
+	Short: "registers the snapshot repository.",
+	Long: fmt.Sprintf(`
+Register the snapshot repository of the '--type', or update the registered one.
+The '%s' repository requires '--location', which must be listed in the 'path.repo' setting of all nodes.
+The '%s' repository requires '--bucket' and the repository-s3 plugin installed on all nodes.
+The other settings of the repository are set by '--setting key=value'.
+The repository is verified by all nodes unless '--no-verify' is set.
+`, snapshots.RepositoryFS, snapshots.RepositoryS3),
+	Example: `
+opensearch-cli snapshot repo create backup --location /mnt/snapshots
+opensearch-cli snapshot repo create backup --location /mnt/snapshots --setting compress=true --setting max_snapshot_bytes_per_sec=100mb
+opensearch-cli snapshot repo create s3-backup --type s3 --bucket my-bucket --base-path opensearch --setting region=eu-west-1
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		repositoryType := flagutils.GetStringFlagInSet(cmd.Flags(), TypeFlag, snapshots.RepositoryTypes)
+		pairs := flagutils.GetStringArrayFlag(cmd.Flags(), SettingFlag)
+		for _, flag := range []string{LocationFlag, BucketFlag, BasePathFlag} {
+			if value := flagutils.GetStringFlag(cmd.Flags(), flag); value != "" {
+				pairs = append(pairs, strings.ReplaceAll(flag, "-", "_")+"="+value)
+			}
+		}
+		body, err := snapshots.RepositoryBody(repositoryType, pairs)
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		if err := api.NewFromCmd(cmd).CreateRepository(args[0], body, !flagutils.GetBoolFlag(cmd.Flags(), NoVerifyFlag)); err != nil {
+			log.Fatal().Msgf("failed to create snapshot repository:%v", err)
+		}
+		log.Info().Msgf("snapshot repository '%s' created", args[0])
+	},
+}
+
+var snapshotRepoVerifyCmd = &cobra.Command{
+	Use:   "verify <repository>",
+	Short: "verifies the snapshot repository is accessible by the nodes.",
+	Long:  `Verify the snapshot repository is accessible by all nodes, list the nodes which verified it.`,
+	Example: `
+opensearch-cli snapshot repo verify backup
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		verification, err := api.NewFromCmd(cmd).VerifyRepository(args[0])
+		if err != nil {
+			log.Fatal().Msgf("snapshot repository '%s' verification failed:%v", args[0], err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(verification)
+	},
+}
+
+var snapshotRepoDeleteCmd = &cobra.Command{
+	Use:     "delete <repository>",
+	Aliases: []string{"rm"},
+	Short:   "⚠️unregisters the snapshot repository.",
+	Long: `
+Unregister the snapshot repository, the snapshots stored in it are kept and are available again
+once the repository is registered with the same settings.
+`,
+	Example: `
+opensearch-cli snapshot repo delete backup
+opensearch-cli snapshot repo delete backup --approve
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewFromCmd(cmd)
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to delete snapshot repository '%s'?", client.Config.Current, args[0]))) {
+			return
+		}
+		if err := client.DeleteRepository(args[0]); err != nil {
+			log.Fatal().Msgf("failed to delete snapshot repository:%v", err)
+		}
+		log.Info().Msgf("snapshot repository '%s' deleted", args[0])
+	},
+}
+
+func init() {
+	snapshotRepoCreateCmd.Flags().String(TypeFlag, snapshots.RepositoryFS,
+		fmt.Sprintf("type of the repository, one of: %s", strings.Join(snapshots.RepositoryTypes, "|")))
+	snapshotRepoCreateCmd.Flags().String(LocationFlag, "", "path of the fs repository.")
+	snapshotRepoCreateCmd.Flags().String(BucketFlag, "", "bucket of the s3 repository.")
+	snapshotRepoCreateCmd.Flags().String(BasePathFlag, "", "path of the s3 repository in the bucket.")
+	snapshotRepoCreateCmd.Flags().StringArray(SettingFlag, nil, "setting of the repository in the form key=value, can be repeated.")
+	snapshotRepoCreateCmd.Flags().Bool(NoVerifyFlag, false, "register the repository without verifying it.")
+	snapshotRepoDeleteCmd.Flags().Bool(ConfirmFlag, false, "delete the repository without confirmation")
+}
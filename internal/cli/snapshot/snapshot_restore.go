@@ -0,0 +1,112 @@
+package snapshot
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/snapshots"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore <repository> <snapshot>",
+	Short: "⚠️restores the indices from the snapshot.",
+	Long: fmt.Sprintf(`
+Restore all indices of the snapshot, or the '--indices' selected by the names or the patterns,
+the names starting with '-' exclude the indices. The restored index can't replace the open index with the same name,
+close or delete it first, or restore the index under the new name with '--rename-pattern'(the regular expression)
+and '--rename-replacement', which can refer to the capture groups as $1.
+The restore is started in the background and its progress is watched until all primary shards are restored,
+the restore of any primary shard fails or the '--timeout' elapses, the interrupted command doesn't stop the restore.
+%s
+`, gu.WildHelp),
+	Example: `
+opensearch-cli snapshot restore backup nightly-2024.06.01
+opensearch-cli snapshot restore backup nightly-2024.06.01 --indices 'orders-*,-orders-tmp'
+opensearch-cli snapshot restore backup nightly-2024.06.01 --indices orders --rename-pattern '(.+)' --rename-replacement 'restored-$1'
+opensearch-cli snapshot restore backup nightly-2024.06.01 --index-setting index.number_of_replicas=0 --approve
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		repository, name := args[0], args[1]
+		opts := snapshots.RestoreOptions{
+			Indices:            flagutils.GetStringSliceFlag(cmd.Flags(), IndicesFlag),
+			RenamePattern:      flagutils.GetStringFlag(cmd.Flags(), RenamePatternFlag),
+			RenameReplacement:  flagutils.GetStringFlag(cmd.Flags(), RenameReplacementFlag),
+			IncludeAliases:     flagutils.GetBoolFlag(cmd.Flags(), IncludeAliasesFlag),
+			IncludeGlobalState: flagutils.GetBoolFlag(cmd.Flags(), IncludeGlobalStateFlag),
+			Partial:            flagutils.GetBoolFlag(cmd.Flags(), PartialFlag),
+		}
+		var err error
+		if opts.IndexSettings, err = indices.SettingsBody(flagutils.GetStringArrayFlag(cmd.Flags(), IndexSettingFlag)); err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		if err := opts.Validate(); err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		client := api.NewFromCmd(cmd)
+		list, err := client.GetSnapshots(repository, name)
+		if err != nil || len(list) != 1 {
+			log.Fatal().Msgf("failed to get snapshot '%s':%v", name, err)
+		}
+		plan, err := opts.Plan(list[0].Indices, gu.GetMatchFunc)
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		sources := slices.Sorted(maps.Keys(plan))
+		lines := make([]string, 0, len(sources))
+		for _, source := range sources {
+			lines = append(lines, fmt.Sprintf("%s -> %s", source, plan[source]))
+		}
+		log.Info().Msgf("indices to restore:\n%s", strings.Join(lines, "\n"))
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to restore %d indices from snapshot '%s'?",
+						client.Config.Current, len(sources), name))) {
+			return
+		}
+		opts.Indices = sources
+		if err := client.RestoreSnapshot(repository, name, opts); err != nil {
+			log.Fatal().Msgf("failed to restore snapshot:%v", err)
+		}
+		log.Info().Msgf("restore of snapshot '%s' started", name)
+		if flagutils.GetBoolFlag(cmd.Flags(), DetachFlag) {
+			return
+		}
+		statuses, err := client.SnapshotStatus(repository, []string{name})
+		if err != nil || len(statuses) != 1 {
+			log.Fatal().Msgf("failed to get snapshot status:%v", err)
+		}
+		var primaries int64
+		for _, source := range sources {
+			primaries += int64(statuses[0].Indices[source].ShardsStats.Total)
+		}
+		timeout, err := cmd.Flags().GetDuration(TimeoutFlag)
+		if err != nil {
+			log.Fatal().Msgf("%v", err)
+		}
+		watchRestore(client, slices.Sorted(maps.Values(plan)), primaries, timeout)
+		log.Info().Msgf("%d indices restored from snapshot '%s'", len(sources), name)
+	},
+}
+
+func init() {
+	snapshotRestoreCmd.Flags().StringSlice(IndicesFlag, nil, "comma separated indices or patterns to restore, all indices of the snapshot by default.")
+	snapshotRestoreCmd.Flags().String(RenamePatternFlag, "", "regular expression matching the part of the index names to replace.")
+	snapshotRestoreCmd.Flags().String(RenameReplacementFlag, "", "replacement of the rename pattern matches.")
+	snapshotRestoreCmd.Flags().Bool(IncludeAliasesFlag, true, "restore the aliases of the indices.")
+	snapshotRestoreCmd.Flags().Bool(IncludeGlobalStateFlag, false, "restore the cluster state, the templates and the persistent settings.")
+	snapshotRestoreCmd.Flags().Bool(PartialFlag, false, "allow the restore of the partial snapshot.")
+	snapshotRestoreCmd.Flags().StringArray(IndexSettingFlag, nil, "setting of the restored indices in the form key=value, can be repeated.")
+	snapshotRestoreCmd.Flags().Bool(DetachFlag, false, "don't wait for the restore to complete.")
+	snapshotRestoreCmd.Flags().Duration(TimeoutFlag, time.Hour, "maximal time to wait for the restore, the restore isn't stopped on the timeout.")
+	snapshotRestoreCmd.Flags().Bool(ConfirmFlag, false, "restore the indices without confirmation")
+}
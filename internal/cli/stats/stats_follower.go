@@ -2,7 +2,7 @@ package stats
 
 import (
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/spf13/cobra"
 )
 
@@ -11,8 +11,10 @@ var followerRStatsCmd = &cobra.Command{
 	Short:   "show follower replication stats.",
 	Example: `opensearch-cli stats follower`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if _, err := api.NewFromCmd(cmd).GetReplicationFollowerStats(flagutils.GetBoolFlag(cmd.Flags(), RawFlag)); err != nil {
+		stats, err := api.NewFromCmd(cmd).GetReplicationFollowerStats()
+		if err != nil {
 			log.Fatal().Msgf("failed to get follower stats:%v", err)
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(stats)
 	},
 }
@@ -1,18 +1,16 @@
 package stats
 
 import (
-	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/api"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	tstats "github.com/dalet-oss/opensearch-cli/pkg/api/types/stats"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
 	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
 	"github.com/spf13/cobra"
 	"sort"
 )
 
-const RawFlag = "raw"
-
 var lagCmd = &cobra.Command{
 	Use:     "lag",
 	Short:   "show lag information for a specific index.",
@@ -20,6 +18,7 @@ var lagCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		replicationIndex := ""
 		client := api.NewFromCmd(cmd)
+		result := tstats.IndexReplicationStatsList{}
 		if len(args) == 0 || args[0] == "" {
 			log.Info().Msg("index name is required")
 			registeredIndices, indexListErr := client.GetIndexList()
@@ -31,15 +30,11 @@ var lagCmd = &cobra.Command{
 			})
 			sort.Strings(indexNames)
 			replicationIndex = prompts.SelectivePrompt("Select index for query", indexNames)
-			fmt.Println("selected index: ", replicationIndex)
-			if _, err := client.GetStatsLag(replicationIndex, flagutils.GetBoolFlag(cmd.Flags(), RawFlag)); err != nil {
-				log.Fatal().Err(err)
-			}
+			log.Info().Msgf("selected index: %s", replicationIndex)
+			result = append(result, queryLag(client, replicationIndex))
 		} else if !gu.ContainsWildcard(args[0]) {
 			replicationIndex = args[0]
-			if _, err := client.GetStatsLag(replicationIndex, flagutils.GetBoolFlag(cmd.Flags(), RawFlag)); err != nil {
-				log.Fatal().Err(err)
-			}
+			result = append(result, queryLag(client, replicationIndex))
 		} else {
 			registeredIndices, indexListErr := client.GetIndexList()
 			if indexListErr != nil {
@@ -56,11 +51,22 @@ var lagCmd = &cobra.Command{
 					"found %d %s for %s expression",
 					len(filtered), fp.Ternary("index", "indices", len(filtered) == 1), args[0])
 				for _, index := range filtered {
-					if _, err := client.GetStatsLag(index, flagutils.GetBoolFlag(cmd.Flags(), RawFlag)); err != nil {
-						log.Fatal().Err(err)
-					}
+					result = append(result, queryLag(client, index))
 				}
 			}
 		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
 	},
 }
+
+// queryLag queries the replication lag of the index, the follower index name is filled in if the cluster doesn't report it.
+func queryLag(client *api.OpensearchWrapper, index string) tstats.IndexReplicationStatsResponse {
+	stats, err := client.GetStatsLag(index)
+	if err != nil {
+		log.Fatal().Msgf("failed to get replication lag for index '%s':%v", index, err)
+	}
+	if stats.FollowerIndex == "" {
+		stats.FollowerIndex = index
+	}
+	return stats
+}
@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/spf13/cobra"
+	"time"
+)
+
+var log = logging.Logger()
+
+// pollInterval is the interval between the task requests while the task is watched.
+const pollInterval = 2 * time.Second
+
+const (
+	ConfirmFlag = "approve"
+	ActionsFlag = "actions"
+	NodesFlag   = "nodes"
+	ParentFlag  = "parent"
+	WaitFlag    = "wait"
+)
+
+func NewTasksCmd() *cobra.Command {
+	// subcommands
+	tasksCmd.AddCommand(
+		tasksListCmd,
+		tasksGetCmd,
+		tasksCancelCmd,
+	)
+	return tasksCmd
+}
+
+// tasksCmd represents the tasks command
+var tasksCmd = &cobra.Command{
+	Use:     "tasks",
+	Aliases: []string{"task"},
+	Short:   "tasks commands",
+	Long:    `Set of commands for the long-running tasks of the cluster, e.g. reindex, force merge or replication bootstrap`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.HasAvailableSubCommands() {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		}
+	},
+}
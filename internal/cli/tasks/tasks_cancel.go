@@ -0,0 +1,85 @@
+package tasks
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/tasks"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+)
+
+var tasksCancelCmd = &cobra.Command{
+	Use:   "cancel <task|pattern>",
+	Short: "⚠️cancels tasks.",
+	Long: `
+Cancel the task with the 'node:id' identifier, or all cancellable tasks with the action compliant with the pattern,
+optionally only the tasks running on the '--nodes' or started by the '--parent' task.
+The tasks are stopped asynchronously, the cancelled task can keep running for a while.
+`,
+	Example: `
+opensearch-cli tasks cancel node-1:1234
+opensearch-cli tasks cancel '*byquery' --nodes node-1
+opensearch-cli tasks cancel 'indices:data/write/reindex' --approve
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if tasks.IsTaskID(args[0]) {
+			for _, flag := range []string{NodesFlag, ParentFlag} {
+				if cmd.Flags().Changed(flag) {
+					log.Fatal().Msgf("flag '--%s' can't be used with the task identifier, it selects the tasks of the pattern", flag)
+				}
+			}
+		}
+		client := api.NewFromCmd(cmd)
+		var taskIDs []string
+		if tasks.IsTaskID(args[0]) {
+			taskIDs = []string{args[0]}
+		} else {
+			list, err := client.ListTasks(tasks.ListOptions{
+				Actions:      []string{args[0]},
+				Nodes:        flagutils.GetStringSliceFlag(cmd.Flags(), NodesFlag),
+				ParentTaskID: flagutils.GetStringFlag(cmd.Flags(), ParentFlag),
+			})
+			if err != nil {
+				log.Fatal().Msgf("failed to list tasks:%v", err)
+			}
+			list = fp.Filter(list, func(task tasks.Task) bool {
+				return task.Cancellable && !task.Cancelled
+			})
+			if len(list) == 0 {
+				log.Warn().Msgf("no cancellable tasks found for %s expression", args[0])
+				return
+			}
+			printutils.NewPrinter(printutils.FormatTable, cmd.ErrOrStderr()).PrintOrDie(list)
+			taskIDs = fp.Map(list, tasks.Task.TaskID)
+		}
+		subject := fp.Ternary(fmt.Sprintf("%d tasks", len(taskIDs)), fmt.Sprintf("task '%s'", taskIDs[0]), len(taskIDs) > 1)
+		if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+			!prompts.IsOk(
+				prompts.QuestionPrompt(
+					fmt.Sprintf("[context:%s]Are you sure you want to cancel %s?", client.Config.Current, subject))) {
+			return
+		}
+		failed := 0
+		for _, taskID := range taskIDs {
+			if err := client.CancelTask(taskID); err != nil {
+				log.Error().Msgf("❌fail to cancel task '%s':%v", taskID, err)
+				failed++
+				continue
+			}
+			log.Info().Msgf("✅task '%s' is cancelled", taskID)
+		}
+		if failed > 0 {
+			log.Fatal().Msgf("fail to cancel %d of %d tasks", failed, len(taskIDs))
+		}
+	},
+}
+
+func init() {
+	tasksCancelCmd.Flags().StringSlice(NodesFlag, nil, "comma separated node identifiers or names, used with the pattern.")
+	tasksCancelCmd.Flags().String(ParentFlag, "", "identifier of the parent task in the form 'node:id', used with the pattern.")
+	tasksCancelCmd.Flags().Bool(ConfirmFlag, false, "cancel the tasks without confirmation")
+}
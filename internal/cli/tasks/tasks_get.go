@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/reindex"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/tasks"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+	"time"
+)
+
+var tasksGetCmd = &cobra.Command{
+	Use:   "get <task>",
+	Short: "shows the task.",
+	Long: `
+Show the state of the task with the 'node:id' identifier, the completed task shows its response or error.
+'--wait' watches the running task until it is completed, the progress bar is rendered for the tasks
+reporting the processed documents, e.g. reindex, update by query and delete by query. The tasks which don't
+store their result, e.g. force merge, are shown in the last watched state once they disappear.
+The command fails if the task completed with the error.
+`,
+	Example: `
+opensearch-cli tasks get node-1:1234
+opensearch-cli tasks get node-1:1234 --wait -o json
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		taskID := args[0]
+		if !tasks.IsTaskID(taskID) {
+			log.Fatal().Msgf("'%s' is not the task identifier in the form 'node:id'", taskID)
+		}
+		client := api.NewFromCmd(cmd)
+		var result tasks.TaskResult
+		if flagutils.GetBoolFlag(cmd.Flags(), WaitFlag) {
+			result = watchTask(client, taskID)
+		} else {
+			var err error
+			if result, err = client.GetTask(taskID); err != nil {
+				log.Fatal().Msgf("failed to get task:%v", err)
+			}
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(result)
+		if result.Completed && len(result.Error) > 0 {
+			log.Fatal().Msgf("task '%s' failed", taskID)
+		}
+	},
+}
+
+// watchTask waits for the task to complete rendering its progress, returns the completed task.
+func watchTask(client *api.OpensearchWrapper, taskID string) tasks.TaskResult {
+	progress := printutils.NewProgress("task", 0)
+	result, err := client.WaitForTask(taskID, pollInterval, func(result tasks.TaskResult) {
+		done, details := taskProgress(result, progress)
+		if result.Completed {
+			progress.Finish(done, details)
+		} else {
+			progress.Update(done, details)
+		}
+	})
+	if err != nil {
+		log.Fatal().Msgf("failed to get task:%v", err)
+	}
+	if len(result.Response) == 0 && len(result.Error) == 0 {
+		log.Info().Msgf("task '%s' is completed, its result isn't stored", taskID)
+	}
+	return result
+}
+
+// taskProgress returns the processed documents of the task and the progress details, the total of the progress
+// is set from the status of the tasks processing the documents in batches.
+func taskProgress(result tasks.TaskResult, progress *printutils.Progress) (int64, string) {
+	progress.Label = result.Task.Action
+	status := result.Task.Status
+	if result.Completed && len(result.Response) > 0 {
+		status = result.Response
+	}
+	var bulk reindex.Status
+	if len(status) == 0 || json.Unmarshal(status, &bulk) != nil || bulk.Total == 0 {
+		return 0, fmt.Sprintf("running %s", result.Task.RunningTime().Round(time.Second))
+	}
+	progress.Total = bulk.Total
+	return bulk.Processed(), bulk.Details(result.Task.RunningTime())
+}
+
+func init() {
+	tasksGetCmd.Flags().Bool(WaitFlag, false, "wait for the task to complete showing its progress.")
+}
@@ -0,0 +1,42 @@
+package tasks
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/tasks"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+)
+
+var tasksListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "lists running tasks.",
+	Long: `
+List the running tasks of the cluster sorted by the start time, optionally only the tasks with the action
+compliant with the '--actions' patterns, running on the '--nodes' or started by the '--parent' task.
+`,
+	Example: `
+opensearch-cli tasks list
+opensearch-cli tasks list --actions '*reindex*' -o wide
+opensearch-cli tasks list --nodes node-1 --parent node-1:1234 -o json
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		list, err := api.NewFromCmd(cmd).ListTasks(tasks.ListOptions{
+			Actions:      flagutils.GetStringSliceFlag(cmd.Flags(), ActionsFlag),
+			Nodes:        flagutils.GetStringSliceFlag(cmd.Flags(), NodesFlag),
+			ParentTaskID: flagutils.GetStringFlag(cmd.Flags(), ParentFlag),
+		})
+		if err != nil {
+			log.Fatal().Msgf("failed to list tasks:%v", err)
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(list)
+	},
+}
+
+func init() {
+	tasksListCmd.Flags().StringSlice(ActionsFlag, nil, "comma separated action names or patterns.")
+	tasksListCmd.Flags().StringSlice(NodesFlag, nil, "comma separated node identifiers or names.")
+	tasksListCmd.Flags().String(ParentFlag, "", "identifier of the parent task in the form 'node:id'.")
+}
@@ -0,0 +1,63 @@
+package template
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/templates"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/spf13/cobra"
+)
+
+var log = logging.Logger()
+
+const (
+	ConfirmFlag   = "approve"
+	DataFlag      = "data"
+	DataFileFlag  = "data-file"
+	CreateFlag    = "create"
+	ComponentFlag = "component"
+)
+
+func NewTemplateCmd() *cobra.Command {
+	// subcommands
+	templateCmd.AddCommand(
+		newKindCmd(templates.KindIndex),
+		newKindCmd(templates.KindComponent),
+		templateSimulateCmd,
+		templateDiffCmd,
+	)
+	return templateCmd
+}
+
+// templateCmd represents the template command
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "template commands",
+	Long:  `Set of commands for index and component templates management`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.HasAvailableSubCommands() {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		}
+	},
+}
+
+// newKindCmd returns the command group managing the templates of the kind.
+func newKindCmd(kind templates.Kind) *cobra.Command {
+	kindCmd := &cobra.Command{
+		Use:   string(kind),
+		Short: string(kind) + " template commands",
+		Long:  `Set of commands for the ` + string(kind) + ` templates management`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cmd.Help(); err != nil {
+				log.Err(err).Msg("failed to show help")
+			}
+		},
+	}
+	kindCmd.AddCommand(
+		newListCmd(kind),
+		newGetCmd(kind),
+		newPutCmd(kind),
+		newDeleteCmd(kind),
+	)
+	return kindCmd
+}
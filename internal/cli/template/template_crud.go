@@ -0,0 +1,138 @@
+package template
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/templates"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(kind templates.Kind) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list [pattern]",
+		Aliases: []string{"ls"},
+		Short:   fmt.Sprintf("lists %s templates.", kind),
+		Long:    fmt.Sprintf(`List the %s templates, only the templates with the name compliant with the pattern if it is set.`, kind),
+		Example: fmt.Sprintf(`
+opensearch-cli template %[1]s list
+opensearch-cli template %[1]s list 'logs*' -o wide
+`, kind),
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pattern := ""
+			if len(args) == 1 {
+				pattern = args[0]
+			}
+			client := api.NewFromCmd(cmd)
+			var list any
+			var err error
+			if kind == templates.KindIndex {
+				list, err = client.GetIndexTemplates(pattern)
+			} else {
+				list, err = client.GetComponentTemplates(pattern)
+			}
+			if err != nil {
+				log.Fatal().Msgf("failed to get %s templates:%v", kind, err)
+			}
+			printutils.FromFlags(cmd.Flags()).PrintOrDie(list)
+		},
+	}
+}
+
+func newGetCmd(kind templates.Kind) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <name>",
+		Short: fmt.Sprintf("shows the %s template definition.", kind),
+		Long:  fmt.Sprintf(`Show the definition of the %s template, the output can be used as the body of the put command.`, kind),
+		Example: fmt.Sprintf(`
+opensearch-cli template %[1]s get logs > logs.json
+opensearch-cli template %[1]s get logs -o yaml
+`, kind),
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			definition, err := api.NewFromCmd(cmd).GetTemplate(kind, args[0])
+			if err != nil {
+				log.Fatal().Msgf("failed to get %s template:%v", kind, err)
+			} else if definition == nil {
+				log.Fatal().Msgf("%s template '%s' not found", kind, args[0])
+			}
+			printutils.FromFlags(cmd.Flags()).PrintOrDie(definition)
+		},
+	}
+}
+
+func newPutCmd(kind templates.Kind) *cobra.Command {
+	putCmd := &cobra.Command{
+		Use:   "put [name]",
+		Short: fmt.Sprintf("creates or replaces the %s template.", kind),
+		Long: fmt.Sprintf(`
+Create or replace the %s template with the definition from '--data', or from the file given by '--data-file'('-' reads stdin).
+The definition can also be the output of the get request with the single template, the name is taken from it if it is not set.
+The template is applied to the indices created after the change only.
+`, kind),
+		Example: fmt.Sprintf(`
+opensearch-cli template %[1]s put logs -f logs.json
+opensearch-cli template %[1]s put logs -f logs.json --create
+opensearch-cli api GET /_%[1]s_template/logs | opensearch-cli template %[1]s put -f -
+`, kind),
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			data, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+			if err != nil {
+				log.Fatal().Msgf("unable to read template:%v", err)
+			} else if data == nil {
+				log.Fatal().Msgf("template is required, use '--%s' or '--%s'", DataFlag, DataFileFlag)
+			}
+			name, definition, err := templates.Definition(kind, data)
+			if err != nil {
+				log.Fatal().Msgf("%v", err)
+			}
+			if len(args) == 1 {
+				name = args[0]
+			} else if name == "" {
+				log.Fatal().Msg("template name is required")
+			}
+			if err := api.NewFromCmd(cmd).PutTemplate(kind, name, definition, flagutils.GetBoolFlag(cmd.Flags(), CreateFlag)); err != nil {
+				log.Fatal().Msgf("failed to put %s template:%v", kind, err)
+			}
+			log.Info().Msgf("%s template '%s' saved", kind, name)
+		},
+	}
+	putCmd.Flags().StringP(DataFlag, "d", "", "template definition.")
+	putCmd.Flags().StringP(DataFileFlag, "f", "", "file with the template definition, '-' reads stdin.")
+	putCmd.Flags().Bool(CreateFlag, false, "fail if the template already exists instead of replacing it.")
+	putCmd.MarkFlagsMutuallyExclusive(DataFlag, DataFileFlag)
+	return putCmd
+}
+
+func newDeleteCmd(kind templates.Kind) *cobra.Command {
+	deleteCmd := &cobra.Command{
+		Use:     "delete <name>",
+		Aliases: []string{"rm"},
+		Short:   fmt.Sprintf("⚠️deletes the %s template.", kind),
+		Long:    fmt.Sprintf(`Delete the %s template, the existing indices are not affected.`, kind),
+		Example: fmt.Sprintf(`
+opensearch-cli template %[1]s delete logs
+opensearch-cli template %[1]s delete logs --approve
+`, kind),
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client := api.NewFromCmd(cmd)
+			if !flagutils.GetBoolFlag(cmd.Flags(), ConfirmFlag) &&
+				!prompts.IsOk(
+					prompts.QuestionPrompt(
+						fmt.Sprintf("[context:%s]Are you sure you want to delete %s template '%s'?", client.Config.Current, kind, args[0]))) {
+				return
+			}
+			if err := client.DeleteTemplate(kind, args[0]); err != nil {
+				log.Fatal().Msgf("failed to delete %s template:%v", kind, err)
+			}
+			log.Info().Msgf("%s template '%s' deleted", kind, args[0])
+		},
+	}
+	deleteCmd.Flags().Bool(ConfirmFlag, false, "delete the template without confirmation")
+	return deleteCmd
+}
@@ -0,0 +1,91 @@
+package template
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/templates"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/config"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+const (
+	// exitDrift is the exit code of the diff command if the drift is found.
+	exitDrift = 1
+	// exitDiffError is the exit code of the diff command if the templates can't be compared, like diff(1) does.
+	exitDiffError = 2
+)
+
+var templateDiffCmd = &cobra.Command{
+	Use:   "diff [name] -f <file>",
+	Short: "compares the local template definition with the cluster one.",
+	Long: `
+Compare the local definition of the index template(or the component template with '--component') with the one
+stored in the cluster and report the drift: the values changed, set locally only or set in the cluster only.
+The settings are compared by their values, so '1' and 1, nested and flat names, with or without 'index.' prefix are equal.
+The name is taken from the file if it is the output of the get request and the name argument is not set.
+The command exits with code 1 if the drift is found and with code 2 if the templates can't be compared,
+so it can be used in CI.
+`,
+	Example: `
+opensearch-cli template diff logs -f templates/logs.json
+opensearch-cli template diff -f logs-get-response.json
+opensearch-cli template diff base-settings -f base-settings.json --component -o json
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		kind := templates.KindIndex
+		if flagutils.GetBoolFlag(cmd.Flags(), ComponentFlag) {
+			kind = templates.KindComponent
+		}
+		data, err := flagutils.GetDataFlag(cmd.Flags(), DataFlag, DataFileFlag, cmd.InOrStdin())
+		if err != nil {
+			failDiff("unable to read template:%v", err)
+		} else if data == nil {
+			failDiff("local template is required, use '--%s' or '--%s'", DataFileFlag, DataFlag)
+		}
+		name, local, err := templates.Definition(kind, data)
+		if err != nil {
+			failDiff("%v", err)
+		}
+		if len(args) == 1 {
+			name = args[0]
+		} else if name == "" {
+			failDiff("template name is required")
+		}
+		client, err := api.New(config.LoadConfig(config.ConfigPath(cmd)), config.CreateApiContext(cmd))
+		if err != nil {
+			failDiff("unable to create client:%v", err)
+		}
+		cluster, err := client.GetTemplate(kind, name)
+		if err != nil {
+			failDiff("failed to get %s template:%v", kind, err)
+		} else if cluster == nil {
+			log.Warn().Msgf("%s template '%s' doesn't exist in the cluster", kind, name)
+		}
+		drifts, err := templates.Diff(local, cluster)
+		if err != nil {
+			failDiff("failed to compare templates:%v", err)
+		}
+		if len(drifts) == 0 {
+			log.Info().Msgf("%s template '%s' is in sync with the cluster", kind, name)
+			return
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(drifts)
+		os.Exit(exitDrift)
+	},
+}
+
+// failDiff logs the error and exits with exitDiffError, log.Fatal would exit with the code of the drift.
+func failDiff(format string, args ...any) {
+	log.Error().Msgf(format, args...)
+	os.Exit(exitDiffError)
+}
+
+func init() {
+	templateDiffCmd.Flags().StringP(DataFileFlag, "f", "", "file with the local template definition, '-' reads stdin.")
+	templateDiffCmd.Flags().StringP(DataFlag, "d", "", "local template definition.")
+	templateDiffCmd.Flags().Bool(ComponentFlag, false, "compare the component template instead of the index template.")
+	templateDiffCmd.MarkFlagsMutuallyExclusive(DataFlag, DataFileFlag)
+}
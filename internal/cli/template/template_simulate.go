@@ -0,0 +1,33 @@
+package template
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var templateSimulateCmd = &cobra.Command{
+	Use:   "simulate <index-name>",
+	Short: "shows the settings and mappings the new index would get.",
+	Long: `
+Show the effective settings, mapped fields and aliases the index with the name would get from the matching templates
+if it was created now. The overlapping templates with the lower priority are reported as warnings.
+Use '-o json' to get the full template.
+`,
+	Example: `
+opensearch-cli template simulate logs-2024.06.01
+opensearch-cli template simulate logs-2024.06.01 -o json
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		simulation, err := api.NewFromCmd(cmd).SimulateIndex(args[0])
+		if err != nil {
+			log.Fatal().Msgf("failed to simulate index:%v", err)
+		}
+		for _, o := range simulation.Overlapping {
+			log.Warn().Msgf("overlapping template '%s' with patterns '%s' is not applied", o.Name, strings.Join(o.IndexPatterns, ","))
+		}
+		printutils.FromFlags(cmd.Flags()).PrintOrDie(simulation)
+	},
+}
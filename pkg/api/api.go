@@ -2,14 +2,17 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
 	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	configutils "github.com/dalet-oss/opensearch-cli/pkg/utils/config"
-	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
 	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/opensearch-project/opensearch-go/v4"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
 	"github.com/spf13/cobra"
+	"net/http"
 )
 import "github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
 
@@ -34,7 +37,7 @@ func (api *OpensearchWrapper) requestContext() (context.Context, context.CancelF
 // NewFromCmd creates a new OpensearchWrapper instance using the provided cobra.Command for configuration and context.
 func NewFromCmd(cmd *cobra.Command) *OpensearchWrapper {
 	wrapper, err := New(
-		configutils.LoadConfig(flagutils.GetStringFlag(cmd.Flags(), consts.ConfigFlag)),
+		configutils.LoadConfig(configutils.ConfigPath(cmd)),
 		configutils.CreateApiContext(cmd),
 	)
 	if err != nil {
@@ -44,9 +47,24 @@ func NewFromCmd(cmd *cobra.Command) *OpensearchWrapper {
 	return wrapper
 }
 
+// NewForContext creates a new OpensearchWrapper instance for the named context of the config instead of the current one,
+// it is used by the commands talking to the second cluster, e.g. the leader cluster of the replication.
+func NewForContext(cmd *cobra.Command, contextName string) *OpensearchWrapper {
+	config := configutils.LoadConfig(configutils.ConfigPath(cmd))
+	if config.GetContext(contextName) == nil {
+		log.Fatal().Msgf("context '%s' is not found in the config", contextName)
+	}
+	wrapper, err := New(config, context.WithValue(configutils.CreateApiContext(cmd), consts.ContextFlag, contextName))
+	if err != nil {
+		log.Fatal().Msgf("unable to create client for context '%s':%v", contextName, err)
+	}
+	return wrapper
+}
+
 // New creates a new OpensearchWrapper instance using the provided appconfig.AppConfig and context.
+// The current context of the config is replaced by the context override from ctx, if any.
 func New(c appconfig.AppConfig, ctx context.Context) (*OpensearchWrapper, error) {
-	client, err := GetOpenSearchClient(c, ctx)
+	client, c, err := newOpenSearchClient(c, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -56,19 +74,20 @@ func New(c appconfig.AppConfig, ctx context.Context) (*OpensearchWrapper, error)
 	}, nil
 }
 
-// ClusterSettings retrieves and logs the current settings of the OpenSearch cluster, excluding default settings.
-func (api *OpensearchWrapper) ClusterSettings() error {
+// ClusterSettings retrieves the current settings of the OpenSearch cluster, excluding default settings.
+func (api *OpensearchWrapper) ClusterSettings() (map[string]interface{}, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
-	var rspData interface{}
-	_, err := api.Client.Do(ctx, opensearchapi.ClusterGetSettingsReq{
+	var rspData map[string]interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.ClusterGetSettingsReq{
 		Params: opensearchapi.ClusterGetSettingsParams{IncludeDefaults: opensearch.ToPointer(false)},
 	}, &rspData)
 	if err != nil {
-		return err
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
 	}
-	log.Info().Msgf("opensearch cluster settings:\n%s", printutils.MarshalJSONOrDie(rspData))
-	return nil
+	return rspData, nil
 }
 
 // PluginsList retrieves and logs the list of installed plugins from the OpenSearch cluster.
@@ -83,3 +102,43 @@ func (api *OpensearchWrapper) PluginsList() ([]opensearchapi.CatPluginResp, erro
 	}
 	return rspData, nil
 }
+
+// NodeInfo describes the cluster node which answered the request.
+type NodeInfo struct {
+	// Address holds the scheme and host of the node the request was sent to.
+	Address     string `json:"address"`
+	Name        string `json:"name"`
+	ClusterName string `json:"cluster_name"`
+	Version     string `json:"version"`
+}
+
+// AnsweringNode sends the info request to the cluster and returns the node which answered it.
+// The request is performed on the transport level, since only the raw HTTP response keeps the address of the selected node.
+func (api *OpensearchWrapper) AnsweringNode() (NodeInfo, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result NodeInfo
+	req, err := opensearchapi.InfoReq{}.GetRequest()
+	if err != nil {
+		return result, err
+	}
+	rsp, err := api.Client.Perform(req.WithContext(ctx))
+	if err != nil {
+		return result, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode >= http.StatusBadRequest {
+		return result, fmt.Errorf("node info request failed with status %d", rsp.StatusCode)
+	}
+	var info opensearchapi.InfoResp
+	if err := json.NewDecoder(rsp.Body).Decode(&info); err != nil {
+		return result, err
+	}
+	if rsp.Request != nil && rsp.Request.URL != nil {
+		result.Address = fmt.Sprintf("%s://%s", rsp.Request.URL.Scheme, rsp.Request.URL.Host)
+	}
+	result.Name = info.Name
+	result.ClusterName = info.ClusterName
+	result.Version = info.Version.Number
+	return result, nil
+}
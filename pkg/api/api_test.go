@@ -86,9 +86,9 @@ func TestOpensearchWrapper_ClusterSettings(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) {
 			if !tt.WantErr {
-				assert.NoError(t, tt.Wrapper.ClusterSettings(), "expected to get cluster settings")
+				assert.NoError(t, noResult(tt.Wrapper.ClusterSettings()), "expected to get cluster settings")
 			} else {
-				assert.Error(t, tt.Wrapper.ClusterSettings(), "expected to get error")
+				assert.Error(t, noResult(tt.Wrapper.ClusterSettings()), "expected to get error")
 			}
 		})
 	}
@@ -114,3 +114,37 @@ func TestOpensearchWrapper_PluginsList(t *testing.T) {
 		})
 	}
 }
+
+func TestOpensearchWrapper_AnsweringNode(t *testing.T) {
+	// the first server is unreachable, the request must fail over to the container
+	failoverConfig := ConfigTContainer(opensearchContainer)
+	failoverConfig.Clusters[0].Params.Servers = []string{failoverConfig.Clusters[0].Params.Server}
+	failoverConfig.Clusters[0].Params.Server = "http://127.0.0.1:1"
+	failoverWrapper, err := New(*failoverConfig, contextWithPassword)
+	assert.NoError(t, err, "client with the unreachable node must be created")
+
+	tests := []OSSingleContainerTest{
+		{
+			Name:    "single node",
+			Wrapper: testWrapper(),
+			WantErr: false,
+		},
+		{
+			Name:    "failover to the second node",
+			Wrapper: failoverWrapper,
+			WantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			node, nodeErr := tt.Wrapper.AnsweringNode()
+			if !tt.WantErr {
+				assert.NoError(t, nodeErr, "expected to get answering node")
+				assert.NotEmpty(t, node.Name, "expected node name")
+				assert.NotContains(t, node.Address, "127.0.0.1:1", "unreachable node can't answer")
+			} else {
+				assert.Error(t, nodeErr, "expected to get error")
+			}
+		})
+	}
+}
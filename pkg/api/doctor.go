@@ -0,0 +1,201 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/doctor"
+	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
+	"github.com/dalet-oss/opensearch-cli/pkg/consts"
+	"github.com/opensearch-project/opensearch-go/v4"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ProbeTimeout is the timeout of the single connectivity probe of Diagnose.
+const ProbeTimeout = 5 * time.Second
+
+// Diagnose validates the config and probes the connectivity of the context step by step:
+// DNS resolution, TCP connection and TLS handshake of every cluster address,
+// then the authentication, the info request and the list of installed plugins.
+// The checks which depend on the failed one are reported as skipped.
+func Diagnose(c appconfig.AppConfig, ctx context.Context, name string) doctor.Report {
+	report := doctor.Report{Context: name}
+	if problems := c.Validate(); len(problems) > 0 {
+		for _, problem := range problems {
+			report.Fail("config", "", problem)
+		}
+	} else {
+		report.Pass("config", "", "%d clusters, %d users, %d contexts", len(c.Clusters), len(c.Users), len(c.Contexts))
+	}
+	ccfg := c.GetContext(name)
+	if ccfg == nil {
+		report.Fail("context", name, fmt.Errorf("context '%s' is not found", name))
+		return report
+	}
+	cluster, user := ccfg.GetCluster(c), ccfg.GetUser(c)
+	if cluster == nil || user == nil {
+		report.Fail("context", name, fmt.Errorf("context refers to the missing cluster '%s' or user '%s'", ccfg.Cluster, ccfg.User))
+		return report
+	}
+	report.Pass("context", name, "cluster '%s', user '%s'", cluster.Name, user.Name)
+
+	reachable := false
+	for _, address := range cluster.Params.Addresses() {
+		reachable = probeAddress(&report, cluster.Params, address) || reachable
+	}
+	if !reachable {
+		for _, check := range []string{"auth", "info", "plugins"} {
+			report.Skip(check, cluster.Name, "no cluster address is reachable")
+		}
+		return report
+	}
+	probeCluster(&report, c, context.WithValue(ctx, consts.ContextFlag, name), cluster.Name)
+	return report
+}
+
+// probeAddress runs the DNS, TCP and TLS checks of the single cluster address, returns true if all of them passed.
+func probeAddress(report *doctor.Report, params appconfig.ClusterParams, address string) bool {
+	u, err := url.Parse(address)
+	if err != nil || u.Host == "" {
+		report.Fail("dns", address, fmt.Errorf("invalid server address:%v", err))
+		return false
+	}
+	host, port := u.Hostname(), u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	if net.ParseIP(host) != nil {
+		report.Skip("dns", address, "address is an IP")
+	} else {
+		ctx, cancelFunc := context.WithTimeout(context.Background(), ProbeTimeout)
+		ips, lookupErr := net.DefaultResolver.LookupHost(ctx, host)
+		cancelFunc()
+		if lookupErr != nil {
+			report.Fail("dns", address, lookupErr)
+			report.Skip("tcp", address, "host is not resolved")
+			report.Skip("tls", address, "host is not resolved")
+			return false
+		}
+		report.Pass("dns", address, "%s", strings.Join(ips, ", "))
+	}
+	hostPort := net.JoinHostPort(host, port)
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", hostPort, ProbeTimeout)
+	if err != nil {
+		report.Fail("tcp", address, err)
+		report.Skip("tls", address, "port is not reachable")
+		return false
+	}
+	_ = conn.Close()
+	report.Pass("tcp", address, "connected to %s in %s", hostPort, time.Since(start).Round(time.Millisecond))
+	if u.Scheme != "https" {
+		report.Skip("tls", address, "plain http")
+		return true
+	}
+	tlsConfig, err := params.TLSConfig()
+	if err != nil {
+		report.Fail("tls", address, err)
+		return false
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = host
+	}
+	tlsConn, err := tls.DialWithDialer(&net.Dialer{Timeout: ProbeTimeout}, "tcp", hostPort, tlsConfig)
+	if err != nil {
+		report.Fail("tls", address, err)
+		return false
+	}
+	defer tlsConn.Close()
+	state := tlsConn.ConnectionState()
+	details := tls.VersionName(state.Version)
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		details = fmt.Sprintf("%s, certificate '%s' expires %s", details, cert.Subject.CommonName, cert.NotAfter.Format(time.DateOnly))
+	}
+	if params.SkipTLSVerify {
+		details += ", verification skipped"
+	}
+	report.Pass("tls", address, "%s", details)
+	return true
+}
+
+// probeCluster runs the authentication, info and plugins checks through the OpenSearch client of the context.
+func probeCluster(report *doctor.Report, c appconfig.AppConfig, ctx context.Context, target string) {
+	config, err := BuildOSConfig(c, ctx)
+	if err != nil {
+		report.Fail("auth", target, err)
+		report.Skip("info", target, "client is not configured")
+		report.Skip("plugins", target, "client is not configured")
+		return
+	}
+	client, err := opensearch.NewClient(config)
+	if err != nil {
+		report.Fail("auth", target, err)
+		report.Skip("info", target, "client is not configured")
+		report.Skip("plugins", target, "client is not configured")
+		return
+	}
+	var info opensearchapi.InfoResp
+	status, err := probeRequest(client, "/", &info)
+	switch {
+	case err != nil && status == 0:
+		report.Fail("auth", target, err)
+		report.Fail("info", target, err)
+		report.Skip("plugins", target, "info request failed")
+		return
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		report.Fail("auth", target, err)
+		report.Skip("info", target, "not authenticated")
+		report.Skip("plugins", target, "not authenticated")
+		return
+	case err != nil:
+		report.Pass("auth", target, "authenticated")
+		report.Fail("info", target, err)
+	default:
+		report.Pass("auth", target, "authenticated")
+		report.Pass("info", target, "cluster '%s', node '%s', version %s", info.ClusterName, info.Name, info.Version.Number)
+	}
+	var plugins []opensearchapi.CatPluginResp
+	if _, err := probeRequest(client, "/_cat/plugins?format=json", &plugins); err != nil {
+		report.Fail("plugins", target, err)
+		return
+	}
+	components := []string{}
+	for _, p := range plugins {
+		if !slices.Contains(components, p.Component) {
+			components = append(components, p.Component)
+		}
+	}
+	slices.Sort(components)
+	report.Pass("plugins", target, "%s", strings.Join(components, ", "))
+}
+
+// probeRequest sends the GET request to the path with ProbeTimeout and decodes the JSON response into the result,
+// returns the response status code, 0 if no response was received.
+func probeRequest(client *opensearch.Client, path string, result interface{}) (int, error) {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), ProbeTimeout)
+	defer cancelFunc()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return 0, err
+	}
+	rsp, err := client.Perform(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode >= http.StatusBadRequest {
+		return rsp.StatusCode, fmt.Errorf("request failed with status %s", rsp.Status)
+	}
+	return rsp.StatusCode, json.NewDecoder(rsp.Body).Decode(result)
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/doctor"
+	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDiagnose(t *testing.T) {
+	unreachable := *ConfigTContainer(opensearchContainer)
+	unreachable.Clusters = []appconfig.ClusterConfig{{Name: cName, Params: appconfig.ClusterParams{Server: "http://127.0.0.1:1"}}}
+	tests := []struct {
+		name     string
+		config   appconfig.AppConfig
+		context  string
+		expected map[string]string
+	}{
+		{
+			name:     "healthy cluster",
+			config:   *config,
+			context:  cName,
+			expected: map[string]string{"config": doctor.StatusPass, "tcp": doctor.StatusPass, "auth": doctor.StatusPass, "info": doctor.StatusPass, "plugins": doctor.StatusPass},
+		},
+		{
+			name:     "unreachable cluster",
+			config:   unreachable,
+			context:  cName,
+			expected: map[string]string{"config": doctor.StatusPass, "tcp": doctor.StatusFail, "auth": doctor.StatusSkip, "info": doctor.StatusSkip},
+		},
+		{
+			name:     "missing context",
+			config:   *config,
+			context:  "missing",
+			expected: map[string]string{"context": doctor.StatusFail},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := Diagnose(tt.config, contextWithPassword, tt.context)
+			statuses := map[string]string{}
+			for _, check := range report.Checks {
+				statuses[check.Name] = check.Status
+			}
+			for check, status := range tt.expected {
+				assert.Equal(t, status, statuses[check], "check '%s'", check)
+			}
+		})
+	}
+}
@@ -43,6 +43,8 @@ const (
 	leaderClusterName = "leader-cluster"
 	afName            = "test-autofollow"
 	testIndex         = "test-index"
+	// snapshotsPath is the 'path.repo' of the opensearch server, the fs snapshot repositories are created in it
+	snapshotsPath = "/tmp/snapshots"
 )
 
 var (
@@ -100,6 +102,7 @@ func spinOpenSearch(netAliases []string, net *testcontainers.DockerNetwork, name
 		tcopensearch.WithUsername(osUsername),
 		tcopensearch.WithPassword(osPassword),
 		network.WithNetwork(netAliases, net),
+		testcontainers.WithEnv(map[string]string{"path.repo": snapshotsPath}),
 		testcontainers.WithLabels(map[string]string{
 			"org.testcontainers.service":        "opensearch",
 			"org.testcontainers.container-name": name,
@@ -361,3 +364,8 @@ func TestShotgun(t *testing.T) {
 	t.Logf("index list:\n%v", idx)
 	t.Logf("done in %s", time.Since(start))
 }
+
+// noResult - drops the result of the wrapper call and keeps only the error, used to assert state changing calls in place
+func noResult[T any](_ T, err error) error {
+	return err
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/aliases"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"net/http"
+)
+
+// GetAliases returns the aliases of all indices sorted by the alias and the index name.
+func (api *OpensearchWrapper) GetAliases() (aliases.Aliases, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	result := map[string]struct {
+		Aliases map[string]aliases.Alias `json:"aliases"`
+	}{}
+	// the request without indices and aliases has the invalid path
+	rsp, err := api.Client.Do(ctx, opensearchapi.AliasGetReq{
+		Indices: []string{"_all"},
+		Alias:   []string{"*"},
+		Params:  opensearchapi.AliasGetParams{ExpandWildcards: "all"},
+	}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() && rsp.StatusCode != http.StatusNotFound {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	list := aliases.Aliases{}
+	for index, indexAliases := range result {
+		for name, alias := range indexAliases.Aliases {
+			alias.Alias, alias.Index = name, index
+			list = append(list, alias)
+		}
+	}
+	list.Sort()
+	return list, nil
+}
+
+// UpdateAliases applies the alias actions atomically with the single _aliases request.
+func (api *OpensearchWrapper) UpdateAliases(actions []aliases.Action) error {
+	if len(actions) == 0 {
+		return errors.New("no alias actions to apply")
+	}
+	body, err := json.Marshal(map[string]any{"actions": actions})
+	if err != nil {
+		return err
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.AliasesReq{Body: bytes.NewReader(body)}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/aliases"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOpensearchWrapper_Aliases(t *testing.T) {
+	wrapper := testWrapper()
+	indices := []string{"tc-alias-v1", "tc-alias-v2"}
+	for _, index := range indices {
+		assert.NoError(t, wrapper.CreateIndex(index))
+	}
+	t.Cleanup(func() {
+		for _, index := range indices {
+			_ = wrapper.DeleteIndex(index)
+		}
+	})
+
+	assert.Error(t, wrapper.UpdateAliases(nil))
+	assert.NoError(t, wrapper.UpdateAliases(aliases.AddActions("tc-alias", indices[:1], aliases.Options{})))
+	list, err := wrapper.GetAliases()
+	assert.NoError(t, err)
+	assert.Equal(t, indices[:1], list.Indices("tc-alias"))
+
+	writeIndex := true
+	assert.NoError(t, wrapper.UpdateAliases(
+		aliases.SwapActions("tc-alias", indices[:1], indices[1:], aliases.Options{IsWriteIndex: &writeIndex})))
+	list, err = wrapper.GetAliases()
+	assert.NoError(t, err)
+	assert.Equal(t, indices[1:], list.Indices("tc-alias"))
+
+	info, err := wrapper.GetIndexList()
+	assert.NoError(t, err)
+	for _, index := range info.WithAliases(list) {
+		if index.Index == indices[1] {
+			assert.Equal(t, []string{"tc-alias"}, index.Aliases)
+		}
+	}
+
+	assert.NoError(t, wrapper.UpdateAliases(aliases.RemoveActions("tc-alias", indices[1:])))
+	list, err = wrapper.GetAliases()
+	assert.NoError(t, err)
+	assert.Empty(t, list.Indices("tc-alias"))
+}
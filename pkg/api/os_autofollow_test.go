@@ -47,19 +47,19 @@ func TestOpensearchWrapper_CreateAutofollowRule(t *testing.T) {
 				ccr := getCCR()
 				t.Logf("remote cluster settings:%v", ccr)
 				assert.NotNil(t, c)
-				assert.NoError(t, c.ConfigureRemoteCluster(ccr, true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(ccr)), "expected to configure remote cluster")
 			},
 			PostFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("cleaning func")
-				if err := c.DeleteAutofollow(replication.DeleteAutofollowReq{
+				if _, err := c.DeleteAutofollow(replication.DeleteAutofollowReq{
 					Header: nil,
 					Body: replication.DeleteAutofollowBody{
 						Name: autoFollowRequest.Body.Name,
 					},
-				}, true); err != nil {
+				}); err != nil {
 					t.Logf("failed to delete autofollow rule:%v", err)
 				}
-				if err := c.DeleteRemote(getCCR().RemoteName, true); err != nil {
+				if _, err := c.DeleteRemote(getCCR().RemoteName); err != nil {
 					t.Logf("failed to delete remote cluster:%v", err)
 				}
 			},
@@ -79,7 +79,7 @@ func TestOpensearchWrapper_CreateAutofollowRule(t *testing.T) {
 				tt.ConfigureFunc(t, tt.Wrapper)
 			}
 
-			executionErr := tt.Wrapper.CreateAutofollowRule(tt.CaseInput.(replication.CreateAutofollowReq), true)
+			_, executionErr := tt.Wrapper.CreateAutofollowRule(tt.CaseInput.(replication.CreateAutofollowReq))
 			if tt.WantErr {
 				assert.Error(t, executionErr, "expected to get error")
 			} else {
@@ -128,26 +128,26 @@ func TestOpensearchWrapper_DeleteAutofollow(t *testing.T) {
 			ConfigureFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("creating autofollow rule")
-				assert.NoError(t, c.CreateAutofollowRule(autoFollowRequest, true), "expected to create autofollow rule")
+				assert.NoError(t, noResult(c.CreateAutofollowRule(autoFollowRequest)), "expected to create autofollow rule")
 				t.Log("pre-configuration completed")
 			},
 			PostFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("cleaning for case: delete autofollow rule | but don't set leader alias")
 				ccr := getCCR()
 				t.Log("deleting autofollow rule")
-				if err := c.DeleteAutofollow(replication.DeleteAutofollowReq{
+				if _, err := c.DeleteAutofollow(replication.DeleteAutofollowReq{
 					Header: nil,
 					Body: replication.DeleteAutofollowBody{
 						Name:        autoFollowRequest.Body.Name,
 						LeaderAlias: ccr.RemoteName,
 					},
-				}, true); err != nil {
+				}); err != nil {
 					t.Logf("failed to delete autofollow rule:%v", err)
 				}
 				t.Log("deleting remote cluster")
-				if err := c.DeleteRemote(ccr.RemoteName, true); err != nil {
+				if _, err := c.DeleteRemote(ccr.RemoteName); err != nil {
 					t.Logf("failed to delete remote cluster:%v", err)
 				}
 				t.Log("cleaning completed")
@@ -167,13 +167,13 @@ func TestOpensearchWrapper_DeleteAutofollow(t *testing.T) {
 			ConfigureFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("creating autofollow rule")
-				assert.NoError(t, c.CreateAutofollowRule(autoFollowRequest, true), "expected to create autofollow rule")
+				assert.NoError(t, noResult(c.CreateAutofollowRule(autoFollowRequest)), "expected to create autofollow rule")
 				t.Log("pre-configuration completed")
 			},
 			PostFunc: func(t *testing.T, c *OpensearchWrapper) {
-				if err := c.DeleteRemote(getCCR().RemoteName, true); err != nil {
+				if _, err := c.DeleteRemote(getCCR().RemoteName); err != nil {
 					t.Logf("failed to delete remote cluster:%v", err)
 				}
 			},
@@ -193,7 +193,7 @@ func TestOpensearchWrapper_DeleteAutofollow(t *testing.T) {
 				tt.ConfigureFunc(t, tt.Wrapper)
 			}
 
-			executionErr := tt.Wrapper.DeleteAutofollow(tt.CaseInput.(replication.DeleteAutofollowReq), true)
+			_, executionErr := tt.Wrapper.DeleteAutofollow(tt.CaseInput.(replication.DeleteAutofollowReq))
 			if tt.WantErr {
 				assert.Error(t, executionErr, "expected to get error")
 			} else {
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/bulk"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/search"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultExportSort is the sort of the export, _id is unique only within the index so the documents
+// of several indices are sorted by _index first, otherwise search_after skips the documents with the same _id.
+var DefaultExportSort = []string{"_index", "_id"}
+
+// Import indexes the NDJSON documents read from r into the index with the _bulk API, see bulk.ParseLine for the line format.
+// The documents are sent in batches by the parallel requests, the batches and the documents rejected
+// with 429 Too Many Requests are retried with the exponential backoff.
+// The documents which can't be parsed or indexed are reported in the summary failures,
+// the error is returned only if the input can't be read. progress is called after every batch.
+func (api *OpensearchWrapper) Import(index string, r io.Reader, opts bulk.Options, progress func(bulk.Summary)) (bulk.Summary, error) {
+	opts = opts.WithDefaults()
+	summary := bulk.Summary{}
+	var mu sync.Mutex
+	record := func(result batchResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		summary.Indexed += result.indexed
+		summary.Retries += result.retries
+		summary.Failures = append(summary.Failures, result.failures...)
+		if progress != nil {
+			progress(summary)
+		}
+	}
+	batches := make(chan []bulk.Item)
+	wg := sync.WaitGroup{}
+	for range opts.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				record(api.importBatch(index, batch, opts))
+			}
+		}()
+	}
+
+	reader := bufio.NewReader(r)
+	var batch []bulk.Item
+	var readErr error
+	total := 0
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(data)) > 0 {
+			total++
+			if item, parseErr := bulk.ParseLine(line, data); parseErr != nil {
+				record(batchResult{failures: []bulk.Failure{{Line: line, Reason: parseErr.Error()}}})
+			} else if batch = append(batch, item); len(batch) == opts.BatchSize {
+				batches <- batch
+				batch = nil
+			}
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				readErr = err
+			}
+			break
+		}
+	}
+	if len(batch) > 0 {
+		batches <- batch
+	}
+	close(batches)
+	wg.Wait()
+	summary.Total = total
+	slices.SortFunc(summary.Failures, func(a, b bulk.Failure) int {
+		return a.Line - b.Line
+	})
+	return summary, readErr
+}
+
+// batchResult holds the outcome of the single batch of the import.
+type batchResult struct {
+	indexed  int
+	retries  int
+	failures []bulk.Failure
+}
+
+// importBatch sends the batch and retries the documents rejected with 429 until opts.MaxRetries is reached.
+func (api *OpensearchWrapper) importBatch(index string, items []bulk.Item, opts bulk.Options) batchResult {
+	result := batchResult{}
+	failAll := func(items []bulk.Item, status int, reason string) {
+		for _, item := range items {
+			result.failures = append(result.failures, bulk.Failure{Line: item.Line, ID: item.ID, Status: status, Reason: reason})
+		}
+	}
+	pending := items
+	for attempt := 0; ; attempt++ {
+		var retry []bulk.Item
+		rsp, status, err := api.sendBulk(index, pending, opts.Refresh)
+		switch {
+		case status == http.StatusTooManyRequests:
+			retry = pending
+		case err != nil:
+			failAll(pending, status, err.Error())
+		case len(rsp.Items) != len(pending):
+			failAll(pending, status, fmt.Sprintf("bulk response has %d items, %d expected", len(rsp.Items), len(pending)))
+		default:
+			for i, item := range pending {
+				for _, itemResult := range rsp.Items[i] {
+					switch {
+					case itemResult.Status == http.StatusTooManyRequests:
+						retry = append(retry, item)
+					case itemResult.Error != nil:
+						result.failures = append(result.failures, bulk.Failure{
+							Line:   item.Line,
+							ID:     itemResult.ID,
+							Status: itemResult.Status,
+							Reason: fmt.Sprintf("%s: %s", itemResult.Error.Type, itemResult.Error.Reason),
+						})
+					default:
+						result.indexed++
+					}
+				}
+			}
+		}
+		if len(retry) == 0 {
+			return result
+		}
+		if attempt >= opts.MaxRetries {
+			failAll(retry, http.StatusTooManyRequests, fmt.Sprintf("rejected after %d retries", attempt))
+			return result
+		}
+		backoff := opts.RetryBackoff << attempt
+		log.Debug().Msgf("%d documents rejected with 429, retrying in %s", len(retry), backoff)
+		time.Sleep(backoff)
+		result.retries++
+		pending = retry
+	}
+}
+
+// sendBulk sends the index actions of the items, returns the response status code, 0 if no response was received.
+func (api *OpensearchWrapper) sendBulk(index string, items []bulk.Item, refresh string) (bulk.Response, int, error) {
+	var result bulk.Response
+	body := bytes.Buffer{}
+	for _, item := range items {
+		action, err := item.Action()
+		if err != nil {
+			return result, 0, err
+		}
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(item.Source)
+		body.WriteByte('\n')
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	rsp, err := api.Client.Do(ctx, opensearchapi.BulkReq{
+		Index:  index,
+		Body:   &body,
+		Params: opensearchapi.BulkParams{Refresh: refresh},
+	}, &result)
+	if err != nil {
+		status := 0
+		if rsp != nil {
+			status = rsp.StatusCode
+		}
+		return result, status, err
+	} else if rsp.IsError() {
+		return result, rsp.StatusCode, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, rsp.StatusCode, nil
+}
+
+// Export writes every document of the indices to w as NDJSON lines with _index, _id, _routing and _source,
+// the lines are accepted by Import. The documents are fetched with search_after on the point in time,
+// sorted by DefaultExportSort unless the sort is set, the query and fields of the options are applied.
+// progress is called with the number of written documents after every document.
+func (api *OpensearchWrapper) Export(indices []string, opts search.Options, w io.Writer, progress func(int)) (search.Summary, error) {
+	opts.Size, opts.Paginate = -1, search.PaginatePIT
+	if len(opts.Sort) == 0 {
+		opts.Sort = DefaultExportSort
+	}
+	written := 0
+	return api.Search(indices, opts, func(hit search.Hit) error {
+		hit.Score, hit.Sort = nil, nil
+		line, err := json.Marshal(hit)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+		written++
+		if progress != nil {
+			progress(written)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/bulk"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/document"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/search"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestOpensearchWrapper_ImportExport(t *testing.T) {
+	wrapper := testWrapper()
+	source, target := "tc-import", "tc-import-copy"
+	t.Cleanup(func() {
+		_ = wrapper.DeleteIndex(source)
+		_ = wrapper.DeleteIndex(target)
+	})
+
+	input := strings.Builder{}
+	for n := 0; n < 25; n++ {
+		input.WriteString(fmt.Sprintf("{\"_id\":\"%02d\",\"_routing\":\"r%d\",\"_source\":{\"n\":%d}}\n", n, n%3, n))
+	}
+	input.WriteString("\n{\"n\":\"not a number\"}\n")
+	input.WriteString("not a JSON\n")
+	input.WriteString("{\"n\":100}")
+	summary, err := wrapper.Import(source, strings.NewReader(input.String()), bulk.Options{BatchSize: 7, Concurrency: 3, Refresh: "true"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 28, summary.Total)
+	assert.Equal(t, 26, summary.Indexed)
+	if assert.Len(t, summary.Failures, 2) {
+		assert.Equal(t, 27, summary.Failures[0].Line, "mapping conflict is reported with the line")
+		assert.Equal(t, 400, summary.Failures[0].Status)
+		assert.Equal(t, 28, summary.Failures[1].Line, "invalid JSON is reported with the line")
+	}
+	doc, err := wrapper.GetDocument(source, "07", document.Options{Routing: "r1"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"n":7}`, string(doc.Source))
+
+	exported := bytes.Buffer{}
+	result, err := wrapper.Export([]string{source}, search.Options{Query: "n:<25", PageSize: 4}, &exported, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, result.Returned)
+	assert.Equal(t, 25, strings.Count(exported.String(), "\n"))
+	assert.True(t, strings.HasPrefix(exported.String(), `{"_index":"tc-import","_id":"00","_routing":"r0","_source":{"n":0}}`))
+
+	summary, err = wrapper.Import(target, &exported, bulk.Options{Refresh: "true"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, summary.Indexed)
+	assert.Empty(t, summary.Failures)
+	doc, err = wrapper.GetDocument(target, "07", document.Options{Routing: "r1"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"n":7}`, string(doc.Source))
+}
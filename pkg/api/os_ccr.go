@@ -7,6 +7,8 @@ import (
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
 	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"maps"
+	"slices"
 	"strings"
 )
 
@@ -42,8 +44,36 @@ func (opts CCRCreateOpts) BuildCCRParams() []byte {
 	return printutils.MarshalJSONOrDie(settings)
 }
 
+// RemoteSettings represents the remote clusters configured in the persistent cluster settings, keyed by the remote alias.
+type RemoteSettings map[string]map[string]interface{}
+
+// TableHeader returns the column names of the remote settings table.
+func (r RemoteSettings) TableHeader(bool) []string {
+	return []string{"name", "mode", "proxy address", "seeds"}
+}
+
+// TableRows returns a row per configured remote cluster.
+func (r RemoteSettings) TableRows(bool) [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, name := range slices.Sorted(maps.Keys(r)) {
+		remote := r[name]
+		row := []string{name, "", "", ""}
+		if v, ok := remote["mode"]; ok {
+			row[1] = fmt.Sprintf("%v", v)
+		}
+		if v, ok := remote["proxy_address"]; ok {
+			row[2] = fmt.Sprintf("%v", v)
+		}
+		if v, ok := remote["seeds"]; ok {
+			row[3] = fmt.Sprintf("%v", v)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
 // ConfigureRemoteCluster configures a remote cluster for cross-cluster replication using the provided CCRCreateOpts settings.
-func (api *OpensearchWrapper) ConfigureRemoteCluster(opts CCRCreateOpts, raw bool) error {
+func (api *OpensearchWrapper) ConfigureRemoteCluster(opts CCRCreateOpts) (opensearchapi.ClusterPutSettingsResp, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 	var result opensearchapi.ClusterPutSettingsResp
@@ -52,92 +82,68 @@ func (api *OpensearchWrapper) ConfigureRemoteCluster(opts CCRCreateOpts, raw boo
 		Params: opensearchapi.ClusterPutSettingsParams{Pretty: false},
 	}
 	if rsp, err := api.Client.Do(ctx, params, &result); err != nil {
-		return err
-	} else {
-		if rsp.IsError() {
-			return errors.New(printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return nil
-		} else {
-			log.Info().Msgf("Cross-cluster replication creation result:\n%s\n", printutils.MarshalJSONOrDie(result))
-		}
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	return nil
+	return result, nil
 }
 
-// GetRemoteSettings retrieves the cluster's remote settings from OpenSearch, either in raw or formatted output.
-func (api *OpensearchWrapper) GetRemoteSettings(raw bool) error {
+// GetRemoteSettings retrieves the cluster's remote settings from OpenSearch.
+func (api *OpensearchWrapper) GetRemoteSettings() (RemoteSettings, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 	var result opensearchapi.ClusterGetSettingsResp
 	params := opensearchapi.ClusterGetSettingsReq{Params: opensearchapi.ClusterGetSettingsParams{Pretty: false}}
 	if rsp, err := api.Client.Do(ctx, params, &result); err != nil {
-		return err
-	} else {
-		if rsp.IsError() {
-			return errors.New(printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return nil
-		} else {
-			var persistentSettings map[string]interface{}
-			var clusterRemoteSettings interface{}
-			_ = json.Unmarshal(result.Persistent, &persistentSettings)
-			if clusterSettings, ok := persistentSettings["cluster"]; ok {
-				if clusterSettingsMap, canCast := clusterSettings.(map[string]interface{}); canCast {
-					if rSettings, found := clusterSettingsMap["remote"]; found {
-						clusterRemoteSettings = rSettings
-					} else {
-						return fmt.Errorf("remote settings is not found in the cluster settings,settings:\n%v", printutils.MarshalJSONOrDie(clusterSettings))
-					}
-				} else {
-					return fmt.Errorf("cluster settings is not map, can't cast to map[string]interface{}:\n%s", printutils.MarshalJSONOrDie(clusterSettings))
-				}
-
-			} else {
-				return fmt.Errorf("cluster configuration is not found in the persistent settings,settings:\n%v", result.Persistent)
-			}
-			log.Info().Msgf("Cluster remote settings:\n%s\n", printutils.MarshalJSONOrDie(clusterRemoteSettings))
-		}
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	var persistentSettings map[string]interface{}
+	_ = json.Unmarshal(result.Persistent, &persistentSettings)
+	clusterSettings, ok := persistentSettings["cluster"]
+	if !ok {
+		return nil, fmt.Errorf("cluster configuration is not found in the persistent settings,settings:\n%v", result.Persistent)
+	}
+	clusterSettingsMap, canCast := clusterSettings.(map[string]interface{})
+	if !canCast {
+		return nil, fmt.Errorf("cluster settings is not map, can't cast to map[string]interface{}:\n%s", printutils.MarshalJSONOrDie(clusterSettings))
+	}
+	rSettings, found := clusterSettingsMap["remote"]
+	if !found {
+		return nil, fmt.Errorf("remote settings is not found in the cluster settings,settings:\n%v", printutils.MarshalJSONOrDie(clusterSettings))
 	}
-	return nil
+	remoteSettings := RemoteSettings{}
+	if err := json.Unmarshal(printutils.MarshalJSONOrDie(rSettings), &remoteSettings); err != nil {
+		return nil, fmt.Errorf("unexpected format of the remote settings:%v", err)
+	}
+	return remoteSettings, nil
 }
 
 // DeleteRemote removes the specified remote cluster configuration from the OpenSearch cluster.
 // It returns an error if the operation fails or the remote cluster does not exist.
-// The raw parameter controls whether the raw response is logged.
-func (api *OpensearchWrapper) DeleteRemote(remoteName string, raw bool) error {
+func (api *OpensearchWrapper) DeleteRemote(remoteName string) (opensearchapi.ClusterPutSettingsResp, error) {
+	var result opensearchapi.ClusterPutSettingsResp
 	settings, err := api.getClusterSettings()
 	if err != nil {
-		return err
+		return result, err
 	}
 	remoteDeleteSettings := deleteRemote(remoteName, settings)
 	if len(remoteDeleteSettings) == 0 {
-		return fmt.Errorf("no remote found with name '%s'", remoteName)
+		return result, fmt.Errorf("no remote found with name '%s'", remoteName)
 	}
 
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
-	var result opensearchapi.ClusterPutSettingsResp
 	params := opensearchapi.ClusterPutSettingsReq{
 		Body:   strings.NewReader(string(printutils.MarshalJSONOrDie(remoteDeleteSettings))),
 		Params: opensearchapi.ClusterPutSettingsParams{Pretty: false},
 	}
 	if rsp, err := api.Client.Do(ctx, params, &result); err != nil {
-		return err
-	} else {
-		if rsp.IsError() {
-			return errors.New("delete remote error:" + printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return nil
-		} else {
-			log.Info().Msgf("Delete remote result:\n%s\n", printutils.MarshalJSONOrDie(result))
-		}
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New("delete remote error:" + printutils.RawResponse(rsp))
 	}
-	return nil
+	return result, nil
 }
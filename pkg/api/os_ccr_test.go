@@ -125,7 +125,7 @@ func TestOpensearchWrapper_ConfigureRemoteCluster(t *testing.T) {
 			ConfigureFunc: nil,
 			PostFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("cleaning func for: Normal remote config except server address")
-				if err := c.DeleteRemote("remote-fail-server", true); err != nil {
+				if _, err := c.DeleteRemote("remote-fail-server"); err != nil {
 					t.Logf("failed to delete remote cluster: %s ", err)
 				}
 			},
@@ -143,7 +143,7 @@ func TestOpensearchWrapper_ConfigureRemoteCluster(t *testing.T) {
 				log.Info().Msg("executing configure func")
 				tt.ConfigureFunc(t, tt.Wrapper)
 			}
-			executionErr := tt.Wrapper.ConfigureRemoteCluster(tt.CaseInput.(CCRCreateOpts), true)
+			_, executionErr := tt.Wrapper.ConfigureRemoteCluster(tt.CaseInput.(CCRCreateOpts))
 			if tt.WantErr {
 				assert.Error(t, executionErr, "expected to get error")
 			} else {
@@ -171,12 +171,12 @@ func TestOpensearchWrapper_DeleteRemote(t *testing.T) {
 			ConfigureFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(CCRCreateOpts{
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(CCRCreateOpts{
 					Type:       "",
 					Mode:       "",
 					RemoteName: testRemoteName,
 					RemoteAddr: "fake.local:9300",
-				}, true), "expected to configure remote cluster")
+				})), "expected to configure remote cluster")
 				t.Log("pre-configuration completed")
 			},
 			PostFunc: nil,
@@ -195,7 +195,7 @@ func TestOpensearchWrapper_DeleteRemote(t *testing.T) {
 				log.Info().Msg("executing configure func")
 				tt.ConfigureFunc(t, tt.Wrapper)
 			}
-			executionErr := tt.Wrapper.DeleteRemote(tt.CaseInput.(string), true)
+			_, executionErr := tt.Wrapper.DeleteRemote(tt.CaseInput.(string))
 			if tt.WantErr {
 				assert.Error(t, executionErr, "expected to get error")
 			} else {
@@ -228,12 +228,12 @@ func TestOpensearchWrapper_GetRemoteSettings(t *testing.T) {
 			ConfigureFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(remoteSettings, true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(remoteSettings)), "expected to configure remote cluster")
 				t.Log("pre-configuration completed")
 			},
 			PostFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("cleaning func for: get remote settings that does exist")
-				if err := c.DeleteRemote(remoteSettings.RemoteName, true); err != nil {
+				if _, err := c.DeleteRemote(remoteSettings.RemoteName); err != nil {
 					t.Logf("failed to delete remote cluster: %s ", err)
 				}
 			},
@@ -252,7 +252,7 @@ func TestOpensearchWrapper_GetRemoteSettings(t *testing.T) {
 				tt.ConfigureFunc(t, tt.Wrapper)
 			}
 			// we have to disable raw output because it's printing response as is w/o throwing an error
-			executionErr := tt.Wrapper.GetRemoteSettings(false)
+			_, executionErr := tt.Wrapper.GetRemoteSettings()
 			if tt.WantErr {
 				assert.Error(t, executionErr, "expected to get error")
 			} else {
@@ -2,45 +2,75 @@ package api
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
 	"github.com/dalet-oss/opensearch-cli/pkg/consts"
+	"github.com/dalet-oss/opensearch-cli/pkg/types"
 	"github.com/opensearch-project/opensearch-go/v4"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
 	"net/http"
 	"net/url"
 )
 
+// applyContextOverride returns the config with the current context replaced by the context override from ctx,
+// the override is set by the --context flag or OSCLI_CONTEXT env variable and is never written to the config file.
+func applyContextOverride(c appconfig.AppConfig, ctx context.Context) appconfig.AppConfig {
+	if ctx == nil {
+		return c
+	}
+	if override, ok := ctx.Value(consts.ContextFlag).(string); ok && override != "" {
+		c.Current = override
+	}
+	return c
+}
+
 // BuildOSConfig constructs and returns an OpenSearch configuration based on the given app configuration.
 // It retrieves the active context, cluster, and user details, and incorporates user credentials into the configuration.
 // The function panics if required elements like contexts, clusters, or users are missing or invalid.
 func BuildOSConfig(c appconfig.AppConfig, ctx context.Context) (opensearch.Config, error) {
+	config, _, err := buildOSConfig(c, ctx)
+	return config, err
+}
+
+// buildOSConfig builds the OpenSearch configuration like BuildOSConfig, it is the only place the context override
+// from ctx is applied, so it also returns the app configuration with the override the client is built for.
+func buildOSConfig(c appconfig.AppConfig, ctx context.Context) (opensearch.Config, appconfig.AppConfig, error) {
+	c = applyContextOverride(c, ctx)
 	ccfg := c.GetActiveContext()
 	if ccfg == nil {
-		return opensearch.Config{},
+		return opensearch.Config{}, c,
 			fmt.Errorf("context config is not found, active context is set to '%s'", c.Current)
 	}
 	osConnection := ccfg.GetCluster(c)
 	if osConnection == nil {
-		return opensearch.Config{},
+		return opensearch.Config{}, c,
 			fmt.Errorf("cluster definition '%s' is not found", ccfg.Cluster)
 	}
 	osUser := ccfg.GetUser(c)
 	if osUser == nil {
-		return opensearch.Config{},
+		return opensearch.Config{}, c,
 			fmt.Errorf("user definition '%s' is not found", ccfg.User)
 	}
-	userCreds, err := osUser.GetUserCredentials(ctx)
-	if err != nil {
-		log.Warn().Msg("Unable to get user credentials, check your config file.")
-		return opensearch.Config{}, err
+	userCreds := &types.Creds{}
+	// the user without credentials is allowed when the cluster authenticates it by the client certificate
+	if osUser.HasCredentials() || !osConnection.Params.HasClientCertificate() {
+		var err error
+		if userCreds, err = osUser.GetUserCredentials(ctx); err != nil {
+			log.Warn().Msg("Unable to get user credentials, check your config file.")
+			return opensearch.Config{}, c, err
+		}
 	}
 
+	requestSigner, err := osUser.GetSigner(ctx)
+	if err != nil {
+		return opensearch.Config{}, c, fmt.Errorf("unable to create sigv4 signer of the user '%s':%w", osUser.Name, err)
+	}
+	tlsConfig, err := osConnection.Params.TLSConfig()
+	if err != nil {
+		return opensearch.Config{}, c, fmt.Errorf("invalid TLS settings of the cluster '%s':%w", osConnection.Name, err)
+	}
 	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: osConnection.Params.SkipTLSVerify,
-		},
+		TLSClientConfig: tlsConfig,
 	}
 	if len(osConnection.Params.ProxyUrl) > 0 {
 		transport.Proxy = func(req *http.Request) (*url.URL, error) {
@@ -52,35 +82,65 @@ func BuildOSConfig(c appconfig.AppConfig, ctx context.Context) (opensearch.Confi
 		}
 	}
 	config := opensearch.Config{
-		Transport: transport,
-		Addresses: []string{osConnection.Params.Server},
-		Username:  userCreds.Username,
-		Password:  userCreds.Password,
+		Transport:     transport,
+		Addresses:     osConnection.Params.Addresses(),
+		Username:      userCreds.Username,
+		Password:      userCreds.Password,
+		RetryOnStatus: osConnection.Params.RetryOnStatus,
+		MaxRetries:    osConnection.Params.MaxRetries,
+		Signer:        requestSigner,
+	}
+	if osConnection.Params.DiscoverNodesOnFailure {
+		config.Transport = &discoveringTransport{next: transport}
+	}
+	if !userCreds.IsBasic() {
+		config.Username, config.Password = "", ""
+	}
+	config.Header = userCreds.Header()
+	for k, v := range osUser.User.Headers {
+		config.Header.Set(k, v)
 	}
 	if (ctx.Value(consts.DebugFlag) != nil && ctx.Value(consts.DebugFlag).(bool)) || (c.CliParams != nil && c.CliParams.EnableDebugLogs()) {
 		config.EnableDebugLogger = true
 	}
-	return config, nil
+	return config, c, nil
 }
 
 // GetOpenSearchClient returns an OpenSearch client based on the given app configuration.
 func GetOpenSearchClient(c appconfig.AppConfig, ctx context.Context) (*opensearch.Client, error) {
-	config, osConfigErr := BuildOSConfig(c, ctx)
+	client, _, err := newOpenSearchClient(c, ctx)
+	return client, err
+}
+
+// newOpenSearchClient returns the OpenSearch client like GetOpenSearchClient along with the app configuration
+// with the context override it is built for.
+func newOpenSearchClient(c appconfig.AppConfig, ctx context.Context) (*opensearch.Client, appconfig.AppConfig, error) {
+	config, c, osConfigErr := buildOSConfig(c, ctx)
 	if osConfigErr != nil {
-		return nil, osConfigErr
+		return nil, c, osConfigErr
 	}
 
 	if client, clientInitErr := opensearchapi.NewClient(opensearchapi.Config{Client: config}); clientInitErr != nil {
 		log.Warn().Msg("unable to create client, check your config file.")
-		return nil, clientInitErr
+		return nil, c, clientInitErr
 	} else {
+		if t, ok := config.Transport.(*discoveringTransport); ok {
+			t.discover = client.Client.DiscoverNodes
+		}
+		// the discovery is done synchronously instead of opensearch.Config.DiscoverNodesOnStart,
+		// which runs it in the background and usually doesn't finish before the single cli request is sent
+		if cluster := c.GetActiveContext().GetCluster(c); cluster.Params.DiscoverNodesOnStart {
+			if discoverErr := client.Client.DiscoverNodes(); discoverErr != nil {
+				log.Warn().Msgf("unable to discover cluster nodes:%v", discoverErr)
+			}
+		}
 		if clusterInfo, fetchInfoErr := client.Info(context.Background(), nil); fetchInfoErr != nil {
 			log.Warn().Msg("unable to discover nodes, check your config file.")
-			return nil, fetchInfoErr
+			return nil, c, fetchInfoErr
 		} else {
 			log.Debug().Interface("clusterInfo", clusterInfo).Msg("current cluster info")
 			log.Debug().Msg("client initialized")
 		}
-		return client.Client, nil
+		return client.Client, c, nil
 	}
 }
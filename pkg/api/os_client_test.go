@@ -50,6 +50,28 @@ func TestBuildOSConfig(t *testing.T) {
 			ValidatorFunc: nil,
 			WantErr:       true,
 		},
+		{
+			name: "context override",
+			args: args{
+				c: func() appconfig.AppConfig {
+					c := *ConfigTContainer(opensearchContainer)
+					c.Current = "non-existent"
+					return c
+				}(),
+				ctx: context.WithValue(contextWithPassword, consts.ContextFlag, cName),
+			},
+			ValidatorFunc: nil,
+			WantErr:       false,
+		},
+		{
+			name: "invalid config|context override set to non-existent context",
+			args: args{
+				c:   *ConfigTContainer(opensearchContainer),
+				ctx: context.WithValue(contextWithPassword, consts.ContextFlag, "non-existent"),
+			},
+			ValidatorFunc: nil,
+			WantErr:       true,
+		},
 		{
 			name: "invalid config|current set to non-existent context",
 			args: args{
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/cluster"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ClusterHealth returns the health of the cluster. If waitForStatus is set, the request waits up to the timeout
+// for the status to become at least as good as the requested one, the health is returned with TimedOut set if it doesn't.
+func (api *OpensearchWrapper) ClusterHealth(waitForStatus string, timeout time.Duration) (cluster.Health, error) {
+	ctx, cancelFunc := context.WithTimeout(context.TODO(), api.Config.ServerCallTimeout()+timeout)
+	defer cancelFunc()
+	var result cluster.Health
+	params := opensearchapi.ClusterHealthParams{WaitForStatus: waitForStatus}
+	if waitForStatus != "" {
+		params.Timeout = timeout
+	}
+	rsp, err := api.Client.Do(ctx, opensearchapi.ClusterHealthReq{Params: params}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.StatusCode == http.StatusRequestTimeout {
+		// the client doesn't decode the error responses, the timed out one holds the current health
+		defer rsp.Body.Close()
+		err = json.NewDecoder(rsp.Body).Decode(&result)
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// GetNodes returns the nodes of the cluster sorted by the name.
+func (api *OpensearchWrapper) GetNodes() (cluster.Nodes, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result cluster.Nodes
+	rsp, err := api.Client.Do(ctx, opensearchapi.CatNodesReq{Params: opensearchapi.CatNodesParams{H: cluster.NodeColumns}}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	slices.SortFunc(result, func(a, b cluster.Node) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result, nil
+}
+
+// GetShards returns the shard copies of the indices matching the expression, all indices if it is empty.
+func (api *OpensearchWrapper) GetShards(expression string) (cluster.Shards, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	req := opensearchapi.CatShardsReq{Params: opensearchapi.CatShardsParams{H: cluster.ShardColumns}}
+	if expression != "" {
+		req.Indices = []string{expression}
+	}
+	var result cluster.Shards
+	rsp, err := api.Client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	result.Sort()
+	return result, nil
+}
+
+// AllocationExplain explains the allocation of the shard copy, the first unassigned shard copy is explained if shard is nil.
+func (api *OpensearchWrapper) AllocationExplain(shard *opensearchapi.ClusterAllocationExplainBody, includeYesDecisions bool) (cluster.AllocationExplanation, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result cluster.AllocationExplanation
+	rsp, err := api.Client.Do(ctx, opensearchapi.ClusterAllocationExplainReq{
+		Body:   shard,
+		Params: opensearchapi.ClusterAllocationExplainParams{IncludeYesDecisions: &includeYesDecisions},
+	}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// UpdateClusterSettings applies the cluster settings update, see cluster.SettingsBody.
+func (api *OpensearchWrapper) UpdateClusterSettings(body map[string]any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.ClusterPutSettingsReq{Body: bytes.NewReader(data)}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/cluster"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOpensearchWrapper_Cluster(t *testing.T) {
+	wrapper := testWrapper()
+	index := "tc-cluster-shards"
+	assert.NoError(t, wrapper.CreateIndex(index))
+	t.Cleanup(func() {
+		_ = wrapper.DeleteIndex(index)
+	})
+
+	health, err := wrapper.ClusterHealth("yellow", 30*time.Second)
+	assert.NoError(t, err)
+	assert.False(t, health.TimedOut)
+	assert.Contains(t, []string{"green", "yellow"}, health.Status)
+
+	nodes, err := wrapper.GetNodes()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, nodes)
+	assert.NotEmpty(t, nodes[0].Version)
+
+	shards, err := wrapper.GetShards(index)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, shards)
+	for _, shard := range shards {
+		assert.Equal(t, index, shard.Index)
+	}
+
+	assert.NoError(t, wrapper.UpdateClusterSettings(map[string]any{
+		cluster.ScopePersistent: map[string]any{"cluster.routing.allocation.enable": "all"},
+	}))
+	settings, err := wrapper.ClusterSettings()
+	assert.NoError(t, err)
+	assert.Contains(t, cluster.Settings(settings).TableRows(false),
+		[]string{cluster.ScopePersistent, "cluster.routing.allocation.enable", "all"})
+	reset, err := cluster.SettingsBody([]string{"cluster.routing.allocation.enable="}, cluster.ScopePersistent)
+	assert.NoError(t, err)
+	assert.NoError(t, wrapper.UpdateClusterSettings(reset))
+}
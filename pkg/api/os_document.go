@@ -0,0 +1,146 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/document"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// GetDocument returns the document of the index by its id, fails if the document is not found.
+func (api *OpensearchWrapper) GetDocument(index, id string, opts document.Options) (document.Document, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result document.Document
+	rsp, err := api.Client.Do(ctx, opensearchapi.DocumentGetReq{
+		Index:      index,
+		DocumentID: url.PathEscape(id),
+		Params: opensearchapi.DocumentGetParams{
+			Routing: opts.Routing,
+			Refresh: opts.RefreshOnRead(),
+		},
+	}, &result)
+	if err != nil {
+		return result, err
+	}
+	if rsp.StatusCode == http.StatusNotFound {
+		return result, fmt.Errorf("document '%s' is not found in '%s'", id, index)
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// DocumentExists checks if the document of the index exists without fetching it.
+func (api *OpensearchWrapper) DocumentExists(index, id string, opts document.Options) (bool, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	rsp, err := api.Client.Do(ctx, opensearchapi.DocumentExistsReq{
+		Index:      index,
+		DocumentID: url.PathEscape(id),
+		Params: opensearchapi.DocumentExistsParams{
+			Routing: opts.Routing,
+			Refresh: opts.RefreshOnRead(),
+		},
+	}, nil)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case rsp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case rsp.IsError():
+		// HEAD response has no body, the status is the only detail
+		return false, fmt.Errorf("document exists request failed with status %d", rsp.StatusCode)
+	}
+	return true, nil
+}
+
+// PutDocument indexes the document body, the id is generated by the cluster if empty.
+// create makes the request fail if the document with the id already exists instead of replacing it.
+func (api *OpensearchWrapper) PutDocument(index, id string, body io.Reader, create bool, opts document.Options) (document.Result, error) {
+	var result document.Result
+	if err := opts.Validate(); err != nil {
+		return result, err
+	}
+	params := opensearchapi.IndexParams{
+		Routing:       opts.Routing,
+		Refresh:       opts.Refresh,
+		IfSeqNo:       opts.IfSeqNo,
+		IfPrimaryTerm: opts.IfPrimaryTerm,
+	}
+	if create {
+		params.OpType = "create"
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	rsp, err := api.Client.Do(ctx, opensearchapi.IndexReq{
+		Index:      index,
+		DocumentID: url.PathEscape(id),
+		Body:       body,
+		Params:     params,
+	}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// UpdateDocument partially updates the document, the body is built with document.UpdateBody.
+func (api *OpensearchWrapper) UpdateDocument(index, id string, body io.Reader, opts document.Options) (document.Result, error) {
+	var result document.Result
+	if err := opts.Validate(); err != nil {
+		return result, err
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	rsp, err := api.Client.Do(ctx, opensearchapi.UpdateReq{
+		Index:      index,
+		DocumentID: url.PathEscape(id),
+		Body:       body,
+		Params: opensearchapi.UpdateParams{
+			Routing:       opts.Routing,
+			Refresh:       opts.Refresh,
+			IfSeqNo:       opts.IfSeqNo,
+			IfPrimaryTerm: opts.IfPrimaryTerm,
+		},
+	}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// DeleteDocument deletes the document of the index by its id, fails if the document is not found.
+func (api *OpensearchWrapper) DeleteDocument(index, id string, opts document.Options) (document.Result, error) {
+	var result document.Result
+	if err := opts.Validate(); err != nil {
+		return result, err
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	rsp, err := api.Client.Do(ctx, opensearchapi.DocumentDeleteReq{
+		Index:      index,
+		DocumentID: url.PathEscape(id),
+		Params: opensearchapi.DocumentDeleteParams{
+			Routing:       opts.Routing,
+			Refresh:       opts.Refresh,
+			IfSeqNo:       opts.IfSeqNo,
+			IfPrimaryTerm: opts.IfPrimaryTerm,
+		},
+	}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
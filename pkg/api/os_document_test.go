@@ -0,0 +1,232 @@
+package api
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/document"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const documentTestIndex = "tc-document"
+
+// documentCase is the input of the document API test cases.
+type documentCase struct {
+	ID     string
+	Body   string
+	Create bool
+	Opts   document.Options
+}
+
+// configureDocument creates the test index with the document indexed under the id.
+func configureDocument(id, body string) func(t *testing.T, c *OpensearchWrapper) {
+	return func(t *testing.T, c *OpensearchWrapper) {
+		assert.NoError(t, c.CreateIndex(documentTestIndex), "expected to create index")
+		if id != "" {
+			_, err := c.PutDocument(documentTestIndex, id, strings.NewReader(body), true, document.Options{Refresh: document.RefreshTrue})
+			assert.NoError(t, err, "expected to index document")
+		}
+	}
+}
+
+// cleanDocumentIndex deletes the test index.
+func cleanDocumentIndex(t *testing.T, c *OpensearchWrapper) {
+	assert.NoError(t, c.DeleteIndex(documentTestIndex), "expected to delete index")
+}
+
+// runDocumentTests runs the cases with the configure and post functions, the call result is checked by the caller.
+func runDocumentTests(t *testing.T, tests []OSSingleContainerTest, call func(t *testing.T, c *OpensearchWrapper, input documentCase) error) {
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if tt.PostFunc != nil {
+					tt.PostFunc(t, tt.Wrapper)
+				}
+			})
+			if tt.ConfigureFunc != nil {
+				tt.ConfigureFunc(t, tt.Wrapper)
+			}
+			executionErr := call(t, tt.Wrapper, tt.CaseInput.(documentCase))
+			if tt.WantErr {
+				assert.Error(t, executionErr, "expected to get error")
+			} else {
+				assert.NoError(t, executionErr, "expected to get no error")
+			}
+		})
+	}
+}
+
+func TestOpensearchWrapper_PutDocument(t *testing.T) {
+	tests := []OSSingleContainerTest{
+		{
+			Name:          "create document with the slash in id",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "doc/1", Body: `{"status":"new"}`, Create: true},
+			ConfigureFunc: configureDocument("", ""),
+			PostFunc:      cleanDocumentIndex,
+		},
+		{
+			Name:          "create document that already exists",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "doc/1", Body: `{"status":"new"}`, Create: true},
+			ConfigureFunc: configureDocument("doc/1", `{"status":"old"}`),
+			PostFunc:      cleanDocumentIndex,
+			WantErr:       true,
+		},
+		{
+			Name:          "index document with generated id",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{Body: `{"status":"new"}`},
+			ConfigureFunc: configureDocument("", ""),
+			PostFunc:      cleanDocumentIndex,
+		},
+	}
+	runDocumentTests(t, tests, func(t *testing.T, c *OpensearchWrapper, input documentCase) error {
+		rsp, err := c.PutDocument(documentTestIndex, input.ID, strings.NewReader(input.Body), input.Create, input.Opts)
+		if err == nil {
+			assert.Equal(t, "created", rsp.Result)
+			if input.ID != "" {
+				assert.Equal(t, input.ID, rsp.ID, "id with the slash must be escaped")
+			} else {
+				assert.NotEmpty(t, rsp.ID)
+			}
+		}
+		return err
+	})
+}
+
+func TestOpensearchWrapper_GetDocument(t *testing.T) {
+	tests := []OSSingleContainerTest{
+		{
+			Name:          "get document",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "doc/1", Body: `{"status":"new","n":1}`},
+			ConfigureFunc: configureDocument("doc/1", `{"status":"new","n":1}`),
+			PostFunc:      cleanDocumentIndex,
+		},
+		{
+			Name:          "get document that doesn't exist",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "missing"},
+			ConfigureFunc: configureDocument("", ""),
+			PostFunc:      cleanDocumentIndex,
+			WantErr:       true,
+		},
+	}
+	runDocumentTests(t, tests, func(t *testing.T, c *OpensearchWrapper, input documentCase) error {
+		doc, err := c.GetDocument(documentTestIndex, input.ID, input.Opts)
+		if err == nil {
+			assert.True(t, doc.Found)
+			assert.JSONEq(t, input.Body, string(doc.Source))
+		}
+		return err
+	})
+}
+
+func TestOpensearchWrapper_UpdateDocument(t *testing.T) {
+	script, err := document.UpdateBody(nil, "ctx._source.n += params.n", "", map[string]any{"n": 2}, false)
+	assert.NoError(t, err)
+	stale := 100
+	tests := []OSSingleContainerTest{
+		{
+			Name:          "update document with script",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "doc/1", Body: string(script), Opts: document.Options{Refresh: document.RefreshTrue}},
+			ConfigureFunc: configureDocument("doc/1", `{"status":"new","n":1}`),
+			PostFunc: func(t *testing.T, c *OpensearchWrapper) {
+				doc, err := c.GetDocument(documentTestIndex, "doc/1", document.Options{})
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"status":"new","n":3}`, string(doc.Source))
+				cleanDocumentIndex(t, c)
+			},
+		},
+		{
+			Name:    "update document with stale sequence number",
+			Wrapper: testWrapper(),
+			CaseInput: documentCase{ID: "doc/1", Body: string(script), Opts: document.Options{
+				IfSeqNo:       &stale,
+				IfPrimaryTerm: fp.AsPointer(1),
+			}},
+			ConfigureFunc: configureDocument("doc/1", `{"status":"new","n":1}`),
+			PostFunc:      cleanDocumentIndex,
+			WantErr:       true,
+		},
+	}
+	runDocumentTests(t, tests, func(t *testing.T, c *OpensearchWrapper, input documentCase) error {
+		rsp, err := c.UpdateDocument(documentTestIndex, input.ID, strings.NewReader(input.Body), input.Opts)
+		if err == nil {
+			assert.Equal(t, "updated", rsp.Result)
+		}
+		return err
+	})
+}
+
+func TestOpensearchWrapper_DeleteDocument(t *testing.T) {
+	stale := 100
+	tests := []OSSingleContainerTest{
+		{
+			Name:          "delete document",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "doc/1", Opts: document.Options{Refresh: document.RefreshTrue}},
+			ConfigureFunc: configureDocument("doc/1", `{"status":"new"}`),
+			PostFunc:      cleanDocumentIndex,
+		},
+		{
+			Name:          "delete document that doesn't exist",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "missing"},
+			ConfigureFunc: configureDocument("", ""),
+			PostFunc:      cleanDocumentIndex,
+			WantErr:       true,
+		},
+		{
+			Name:          "delete document with stale sequence number",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "doc/1", Opts: document.Options{IfSeqNo: &stale, IfPrimaryTerm: fp.AsPointer(1)}},
+			ConfigureFunc: configureDocument("doc/1", `{"status":"new"}`),
+			PostFunc:      cleanDocumentIndex,
+			WantErr:       true,
+		},
+		{
+			Name:          "delete document with sequence number without primary term",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "doc/1", Opts: document.Options{IfSeqNo: &stale}},
+			ConfigureFunc: configureDocument("doc/1", `{"status":"new"}`),
+			PostFunc:      cleanDocumentIndex,
+			WantErr:       true,
+		},
+	}
+	runDocumentTests(t, tests, func(t *testing.T, c *OpensearchWrapper, input documentCase) error {
+		rsp, err := c.DeleteDocument(documentTestIndex, input.ID, input.Opts)
+		if err == nil {
+			assert.Equal(t, "deleted", rsp.Result)
+		}
+		return err
+	})
+}
+
+func TestOpensearchWrapper_DocumentExists(t *testing.T) {
+	tests := []OSSingleContainerTest{
+		{
+			Name:          "document exists",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "doc/1", Body: "true"},
+			ConfigureFunc: configureDocument("doc/1", `{"status":"new"}`),
+			PostFunc:      cleanDocumentIndex,
+		},
+		{
+			Name:          "document doesn't exist",
+			Wrapper:       testWrapper(),
+			CaseInput:     documentCase{ID: "missing", Body: "false"},
+			ConfigureFunc: configureDocument("", ""),
+			PostFunc:      cleanDocumentIndex,
+		},
+	}
+	runDocumentTests(t, tests, func(t *testing.T, c *OpensearchWrapper, input documentCase) error {
+		exists, err := c.DocumentExists(documentTestIndex, input.ID, input.Opts)
+		if err == nil {
+			assert.Equal(t, input.Body == "true", exists)
+		}
+		return err
+	})
+}
@@ -1,9 +1,19 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/aliases"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
 	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"maps"
+	"slices"
+	"strings"
 )
 
 type IndexInfo struct {
@@ -17,10 +27,42 @@ type IndexInfo struct {
 	DocsDeleted  string `json:"docs.deleted,omitempty"`
 	StoreSize    string `json:"store.size,omitempty"`
 	PriStoreSize string `json:"pri.store.size,omitempty"`
+	// Aliases is not returned by _cat/indices, it is set by WithAliases.
+	Aliases []string `json:"aliases,omitempty"`
 }
 
 type IndexInfoResponse []IndexInfo
 
+// TableHeader returns the column names of the index list table.
+func (r IndexInfoResponse) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"health", "status", "index", "uuid", "pri", "rep", "docs.count", "docs.deleted", "store.size", "pri.store.size", "aliases"}
+	}
+	return []string{"health", "status", "index", "docs.count", "store.size", "aliases"}
+}
+
+// TableRows returns a row per index.
+func (r IndexInfoResponse) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, i := range r {
+		if wide {
+			rows = append(rows, []string{i.Health, i.Status, i.Index, i.Uuid, i.Pri, i.Rep, i.DocsCount, i.DocsDeleted, i.StoreSize, i.PriStoreSize, strings.Join(i.Aliases, ",")})
+		} else {
+			rows = append(rows, []string{i.Health, i.Status, i.Index, i.DocsCount, i.StoreSize, strings.Join(i.Aliases, ",")})
+		}
+	}
+	return rows
+}
+
+// WithAliases sets the aliases of every index of the list.
+func (r IndexInfoResponse) WithAliases(list aliases.Aliases) IndexInfoResponse {
+	byIndex := list.ByIndex()
+	for i := range r {
+		r[i].Aliases = byIndex[r[i].Index]
+	}
+	return r
+}
+
 // GetIndexList returns a list of all indices.
 // somehow the lib doesn't allow using of the _list/indices endpoint
 // exposed [to the lib code] only the _cat/indices endpoint
@@ -38,32 +80,197 @@ func (api *OpensearchWrapper) GetIndexList() (IndexInfoResponse, error) {
 
 // CreateIndex - create the index in the OpenSearch cluster
 func (api *OpensearchWrapper) CreateIndex(indexName string) error {
+	return api.CreateIndexWithBody(indexName, nil)
+}
+
+// DeleteIndex - delete index from the OpenSearch cluster
+func (api *OpensearchWrapper) DeleteIndex(indexName string) error {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 	var result interface{}
-	rsp, e := api.Client.Do(ctx, opensearchapi.IndicesCreateReq{Index: indexName}, &result)
+	rsp, e := api.Client.Do(ctx, opensearchapi.IndicesDeleteReq{Indices: []string{indexName}}, &result)
 	if e != nil {
 		return e
 	}
 	if rsp.IsError() {
 		return errors.New(printutils.RawResponse(rsp))
 	}
-	log.Info().Msgf("%v", rsp)
 	return nil
 }
 
-// DeleteIndex - delete index from the OpenSearch cluster
-func (api *OpensearchWrapper) DeleteIndex(indexName string) error {
+// CloseIndex - close the index, the closed index keeps its data but can't be read or written until it is opened
+func (api *OpensearchWrapper) CloseIndex(indexName string) error {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 	var result interface{}
-	rsp, e := api.Client.Do(ctx, opensearchapi.IndicesDeleteReq{Indices: []string{indexName}}, &result)
+	rsp, e := api.Client.Do(ctx, opensearchapi.IndicesCloseReq{Index: indexName}, &result)
+	if e != nil {
+		return e
+	}
+	if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// CreateIndexWithBody - create the index with the settings and mappings body, see indices.CreateBody
+func (api *OpensearchWrapper) CreateIndexWithBody(indexName string, body []byte) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	req := opensearchapi.IndicesCreateReq{Index: indexName}
+	if len(body) > 0 {
+		req.Body = bytes.NewReader(body)
+	}
+	rsp, e := api.Client.Do(ctx, req, &result)
 	if e != nil {
 		return e
 	}
 	if rsp.IsError() {
 		return errors.New(printutils.RawResponse(rsp))
 	}
-	log.Info().Msgf("%v", rsp)
 	return nil
 }
+
+// GetIndexMappings returns the mappings of the index, the comma separated list of indices or the pattern.
+func (api *OpensearchWrapper) GetIndexMappings(indexName string) (indices.Mappings, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	result := indices.Mappings{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.MappingGetReq{Indices: []string{indexName}}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// PutIndexMappings adds the new fields to the mappings of the index, the existing fields can't be changed.
+func (api *OpensearchWrapper) PutIndexMappings(indexName string, body []byte) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.MappingPutReq{Indices: []string{indexName}, Body: bytes.NewReader(body)}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// GetIndexSettings returns the flat settings of the index, the comma separated list of indices or the pattern,
+// includeDefaults adds the default values of the settings which are not set explicitly.
+func (api *OpensearchWrapper) GetIndexSettings(indexName string, includeDefaults bool) (indices.Settings, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	result := indices.Settings{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SettingsGetReq{
+		Indices: []string{indexName},
+		Params: opensearchapi.SettingsGetParams{
+			FlatSettings:    fp.AsPointer(true),
+			IncludeDefaults: fp.AsPointer(includeDefaults),
+		},
+	}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// PutIndexSettings updates the dynamic settings of the index, the body is either the settings object or wrapped into 'index' or 'settings'.
+func (api *OpensearchWrapper) PutIndexSettings(indexName string, body []byte) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SettingsPutReq{Indices: []string{indexName}, Body: bytes.NewReader(body)}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// DescribeIndex combines the state, settings, mappings, aliases, doc counts and shard allocation of the single index.
+// The alias is resolved to its index, the error is returned if the name matches more than one index.
+func (api *OpensearchWrapper) DescribeIndex(indexName string) (indices.Description, error) {
+	description := indices.Description{}
+	details := map[string]struct {
+		Aliases  map[string]json.RawMessage `json:"aliases"`
+		Mappings json.RawMessage            `json:"mappings"`
+		Settings map[string]any             `json:"settings"`
+	}{}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	rsp, err := api.Client.Do(ctx, opensearchapi.IndicesGetReq{
+		Indices: []string{indexName},
+		Params:  opensearchapi.IndicesGetParams{FlatSettings: fp.AsPointer(true)},
+	}, &details)
+	if err != nil {
+		return description, err
+	} else if rsp.IsError() {
+		return description, errors.New(printutils.RawResponse(rsp))
+	}
+	if len(details) != 1 {
+		return description, fmt.Errorf("'%s' matches %d indices, describe requires the single index", indexName, len(details))
+	}
+	for name, d := range details {
+		description.Name = name
+		description.Aliases = slices.Sorted(maps.Keys(d.Aliases))
+		description.Mappings = d.Mappings
+		description.Settings = d.Settings
+		description.UUID = gu.FormatValue(d.Settings["index.uuid"])
+		description.Primaries = gu.FormatValue(d.Settings["index.number_of_shards"])
+		description.Replicas = gu.FormatValue(d.Settings["index.number_of_replicas"])
+		description.CreationDate = indices.FormatCreationDate(d.Settings["index.creation_date"])
+	}
+
+	var stats IndexInfoResponse
+	rsp, err = api.Client.Do(ctx, opensearchapi.CatIndicesReq{Indices: []string{description.Name}}, &stats)
+	if err != nil {
+		return description, err
+	} else if rsp.IsError() {
+		return description, errors.New(printutils.RawResponse(rsp))
+	}
+	if i := slices.IndexFunc(stats, func(info IndexInfo) bool { return info.Index == description.Name }); i != -1 {
+		description.Health, description.Status = stats[i].Health, stats[i].Status
+		description.DocsCount, description.DocsDeleted = stats[i].DocsCount, stats[i].DocsDeleted
+		description.StoreSize, description.PriStoreSize = stats[i].StoreSize, stats[i].PriStoreSize
+	}
+
+	var shards []opensearchapi.CatShardResp
+	rsp, err = api.Client.Do(ctx, opensearchapi.CatShardsReq{Indices: []string{description.Name}}, &shards)
+	if err != nil {
+		return description, err
+	} else if rsp.IsError() {
+		return description, errors.New(printutils.RawResponse(rsp))
+	}
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	for _, s := range shards {
+		description.Shards = append(description.Shards, indices.Shard{
+			Shard:            s.Shard,
+			Prirep:           s.Prirep,
+			State:            s.State,
+			Docs:             value(s.Docs),
+			Store:            value(s.Store),
+			Node:             value(s.Node),
+			UnassignedReason: value(s.UnassignedReason),
+		})
+	}
+	slices.SortFunc(description.Shards, func(a, b indices.Shard) int {
+		if a.Shard != b.Shard {
+			return a.Shard - b.Shard
+		}
+		return strings.Compare(a.Prirep, b.Prirep)
+	})
+	return description, nil
+}
@@ -1,7 +1,10 @@
 package api
 
 import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
 	"github.com/stretchr/testify/assert"
+	"slices"
 	"testing"
 )
 
@@ -49,6 +52,26 @@ func TestOpensearchWrapper_DeleteIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestOpensearchWrapper_CloseIndex(t *testing.T) {
+	wrapper := testWrapper()
+	indexToClose := "tc-close-index"
+	assert.NoError(t, wrapper.CreateIndex(indexToClose))
+	t.Cleanup(func() {
+		_ = wrapper.DeleteIndex(indexToClose)
+	})
+	assert.NoError(t, wrapper.CloseIndex(indexToClose))
+	list, err := wrapper.GetIndexList()
+	assert.NoError(t, err)
+	idx := slices.IndexFunc(list, func(info IndexInfo) bool {
+		return info.Index == indexToClose
+	})
+	if assert.NotEqual(t, -1, idx) {
+		assert.Equal(t, "close", list[idx].Status)
+	}
+	assert.Error(t, wrapper.CloseIndex("tc-close-index-missing"))
+}
+
 func TestOpensearchWrapper_CreateIndex(t *testing.T) {
 	tests := []OSSingleContainerTest{
 		{
@@ -138,3 +161,53 @@ func TestOpensearchWrapper_GetIndexList(t *testing.T) {
 		})
 	}
 }
+
+func TestOpensearchWrapper_IndexMappingsAndSettings(t *testing.T) {
+	wrapper := testWrapper()
+	indexName := "tc-index-mappings"
+	body, err := indices.CreateBody(
+		[]byte(`{"number_of_shards":2,"number_of_replicas":0}`),
+		[]byte(`{"mappings":{"properties":{"status":{"type":"keyword"}}}}`))
+	assert.NoError(t, err)
+	assert.NoError(t, wrapper.CreateIndexWithBody(indexName, body))
+	t.Cleanup(func() {
+		_ = wrapper.DeleteIndex(indexName)
+	})
+
+	assert.NoError(t, wrapper.PutIndexMappings(indexName, []byte(`{"properties":{"total":{"type":"double"}}}`)))
+	assert.Error(t, wrapper.PutIndexMappings(indexName, []byte(`{"properties":{"status":{"type":"long"}}}`)),
+		"type of the existing field can't be changed")
+	mappings, err := wrapper.GetIndexMappings(indexName)
+	assert.NoError(t, err)
+	fields, err := indices.MappingFields(mappings[indexName].Mappings)
+	assert.NoError(t, err)
+	assert.Equal(t, []indices.MappingField{{Field: "status", Type: "keyword"}, {Field: "total", Type: "double"}}, fields)
+
+	update, err := indices.SettingsBody([]string{"refresh_interval=30s", "number_of_replicas=1"})
+	assert.NoError(t, err)
+	updateBody, _ := json.Marshal(update)
+	assert.NoError(t, wrapper.PutIndexSettings(indexName, updateBody))
+	assert.Error(t, wrapper.PutIndexSettings(indexName, []byte(`{"index.number_of_shards":"3"}`)),
+		"static setting can't be updated on the open index")
+	settings, err := wrapper.GetIndexSettings(indexName, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "30s", settings[indexName].Settings["index.refresh_interval"])
+	assert.Equal(t, "1", settings[indexName].Settings["index.number_of_replicas"])
+	assert.Empty(t, settings[indexName].Defaults)
+	settings, err = wrapper.GetIndexSettings(indexName, true)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, settings[indexName].Defaults)
+
+	description, err := wrapper.DescribeIndex(indexName)
+	assert.NoError(t, err)
+	assert.Equal(t, indexName, description.Name)
+	assert.Equal(t, "2", description.Primaries)
+	assert.Equal(t, "1", description.Replicas)
+	assert.Equal(t, "0", description.DocsCount)
+	assert.NotEmpty(t, description.CreationDate)
+	assert.Len(t, description.Shards, 4, "2 primaries and 2 replicas, the replicas are unassigned on the single node")
+	_, err = wrapper.DescribeIndex("tc-index-mapp*")
+	assert.NoError(t, err, "pattern matching the single index is allowed")
+	_, err = wrapper.DescribeIndex("tc-index-missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"errors"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/ism"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"net/http"
+)
+
+// policiesPageSize is the number of the policies requested by ListPolicies per page, the API returns 20 by default.
+const policiesPageSize = 1000
+
+// ListPolicies returns all ISM policies, they are requested by pages.
+func (api *OpensearchWrapper) ListPolicies() (ism.Policies, error) {
+	var policies ism.Policies
+	for {
+		page, total, err := api.listPoliciesPage(len(policies))
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, page...)
+		if len(page) == 0 || len(policies) >= total {
+			return policies, nil
+		}
+	}
+}
+
+// listPoliciesPage returns the page of the ISM policies starting at the offset and the total number of the policies.
+func (api *OpensearchWrapper) listPoliciesPage(from int) (ism.Policies, int, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result struct {
+		Policies      ism.Policies `json:"policies"`
+		TotalPolicies int          `json:"total_policies"`
+	}
+	if rsp, err := api.Client.Do(ctx, ism.ListPoliciesReq{From: from, Size: policiesPageSize}, &result); err != nil {
+		return nil, 0, err
+	} else if rsp.IsError() {
+		return nil, 0, errors.New(printutils.RawResponse(rsp))
+	}
+	return result.Policies, result.TotalPolicies, nil
+}
+
+// GetPolicy returns the ISM policy, nil if it doesn't exist.
+func (api *OpensearchWrapper) GetPolicy(policyID string) (*ism.PolicyDocument, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result ism.PolicyDocument
+	rsp, err := api.Client.Do(ctx, ism.GetPolicyReq{PolicyID: policyID}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return &result, nil
+}
+
+// PutPolicy creates the ISM policy or updates the current version of the existing one, see ism.PolicyBody.
+// Returns true if the policy was created.
+func (api *OpensearchWrapper) PutPolicy(policyID string, body []byte) (bool, error) {
+	current, err := api.GetPolicy(policyID)
+	if err != nil {
+		return false, err
+	}
+	req := ism.PutPolicyReq{PolicyID: policyID, Body: body}
+	if current != nil {
+		req.SeqNo, req.PrimaryTerm = fp.AsPointer(current.SeqNo), fp.AsPointer(current.PrimaryTerm)
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	if rsp, err := api.Client.Do(ctx, req, &result); err != nil {
+		return false, err
+	} else if rsp.IsError() {
+		return false, errors.New(printutils.RawResponse(rsp))
+	}
+	return current == nil, nil
+}
+
+// DeletePolicy deletes the ISM policy, the indices managed by it keep the policy until it is removed from them.
+func (api *OpensearchWrapper) DeletePolicy(policyID string) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	if rsp, err := api.Client.Do(ctx, ism.DeletePolicyReq{PolicyID: policyID}, &result); err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// AddPolicy attaches the ISM policy to the indices, the indices which already have a policy are reported as failed.
+func (api *OpensearchWrapper) AddPolicy(indexNames []string, policyID string) (ism.ChangeResult, error) {
+	var result ism.ChangeResult
+	if len(indexNames) == 0 {
+		return result, errors.New("no indices to attach policy to")
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	req := ism.AddPolicyReq{Indices: indexNames, Body: ism.AddPolicyBody{PolicyID: policyID}}
+	if rsp, err := api.Client.Do(ctx, req, &result); err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// ExplainIndices returns the ISM state of the indices, the unmanaged indices are returned without the policy.
+func (api *OpensearchWrapper) ExplainIndices(indexNames []string) (ism.Explanations, error) {
+	if len(indexNames) == 0 {
+		return nil, errors.New("no indices to explain")
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result ism.Explanations
+	if rsp, err := api.Client.Do(ctx, ism.ExplainReq{Indices: indexNames}, &result); err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// RetryIndices retries the failed action of the managed indices, or restarts them from the state if it is not empty.
+func (api *OpensearchWrapper) RetryIndices(indexNames []string, state string) (ism.ChangeResult, error) {
+	var result ism.ChangeResult
+	if len(indexNames) == 0 {
+		return result, errors.New("no indices to retry")
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	req := ism.RetryReq{Indices: indexNames, Body: ism.RetryBody{State: state}}
+	if rsp, err := api.Client.Do(ctx, req, &result); err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
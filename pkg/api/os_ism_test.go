@@ -0,0 +1,75 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/ism"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+const tcPolicy = `{
+  "description": "tc policy",
+  "default_state": "hot",
+  "states": [
+    {"name": "hot", "actions": [], "transitions": [{"state_name": "delete", "conditions": {"min_index_age": "30d"}}]},
+    {"name": "delete", "actions": [{"delete": {}}], "transitions": []}
+  ]
+}`
+
+func TestOpensearchWrapper_ISM(t *testing.T) {
+	wrapper := testWrapper()
+	policyID, index := "tc-ism-policy", "tc-ism-managed"
+	assert.NoError(t, wrapper.CreateIndex(index))
+	t.Cleanup(func() {
+		_ = wrapper.DeleteIndex(index)
+		_ = wrapper.DeletePolicy(policyID)
+	})
+
+	body, err := ism.PolicyBody([]byte(tcPolicy))
+	assert.NoError(t, err)
+	created, err := wrapper.PutPolicy(policyID, body)
+	assert.NoError(t, err)
+	assert.True(t, created)
+	document, err := wrapper.GetPolicy(policyID)
+	assert.NoError(t, err)
+	assert.Equal(t, "hot", document.Summary().DefaultState)
+	// the printed policy is accepted by the update
+	printed, err := json.Marshal(document)
+	assert.NoError(t, err)
+	body, err = ism.PolicyBody(printed)
+	assert.NoError(t, err)
+	created, err = wrapper.PutPolicy(policyID, body)
+	assert.NoError(t, err)
+	assert.False(t, created)
+
+	policies, err := wrapper.ListPolicies()
+	assert.NoError(t, err)
+	ids := fp.Map(policies, func(document ism.PolicyDocument) string {
+		return document.ID
+	})
+	assert.Contains(t, ids, policyID)
+
+	result, err := wrapper.AddPolicy([]string{index}, policyID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.UpdatedIndices)
+	result, err = wrapper.AddPolicy([]string{index}, policyID)
+	assert.NoError(t, err)
+	assert.Equal(t, indices.ResultFailed, result.Results("attach", []string{index})[0].Status)
+
+	assert.Eventually(t, func() bool {
+		explanations, err := wrapper.ExplainIndices([]string{index})
+		return err == nil && len(explanations) == 1 && explanations[0].PolicyID == policyID
+	}, 30*time.Second, 500*time.Millisecond)
+
+	result, err = wrapper.RetryIndices([]string{index}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, result.UpdatedIndices)
+
+	assert.NoError(t, wrapper.DeletePolicy(policyID))
+	document, err = wrapper.GetPolicy(policyID)
+	assert.NoError(t, err)
+	assert.Nil(t, document)
+}
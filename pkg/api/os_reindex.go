@@ -0,0 +1,81 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/reindex"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+)
+
+// Reindex starts the reindex in the background and returns the identifier of its task.
+func (api *OpensearchWrapper) Reindex(opts reindex.Options) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+	body, err := opts.Body()
+	if err != nil {
+		return "", err
+	}
+	slices, _ := opts.SlicesParam()
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result struct {
+		Task string `json:"task"`
+	}
+	rsp, err := api.Client.Do(ctx, opensearchapi.ReindexReq{
+		Body: bytes.NewReader(body),
+		Params: opensearchapi.ReindexParams{
+			Slices:            slices,
+			RequestsPerSecond: opts.RequestsPerSecond,
+			WaitForCompletion: fp.AsPointer(false),
+		},
+	}, &result)
+	if err != nil {
+		return "", err
+	} else if rsp.IsError() {
+		return "", errors.New(printutils.RawResponse(rsp))
+	} else if result.Task == "" {
+		return "", errors.New("reindex task identifier is missing in the response")
+	}
+	return result.Task, nil
+}
+
+// RethrottleReindex changes the throttling of the running reindex task, -1 disables the throttling.
+func (api *OpensearchWrapper) RethrottleReindex(taskID string, requestsPerSecond int) error {
+	if err := reindex.ValidateRequestsPerSecond(requestsPerSecond); err != nil {
+		return err
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.ReindexRethrottleReq{
+		TaskID: taskID,
+		Params: opensearchapi.ReindexRethrottleParams{RequestsPerSecond: &requestsPerSecond},
+	}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// RemoteReindexHost returns the HTTP address of the remote configured by 'ccr create', see reindex.RemoteHost.
+func (api *OpensearchWrapper) RemoteReindexHost(remoteName, scheme string, port int) (string, error) {
+	remotes, err := api.GetRemoteSettings()
+	if err != nil {
+		return "", err
+	}
+	remote, ok := remotes[remoteName]
+	if !ok {
+		return "", fmt.Errorf("no remote found with name '%s'", remoteName)
+	}
+	address, _ := remote["proxy_address"].(string)
+	if seeds, ok := remote["seeds"].([]any); ok && address == "" && len(seeds) > 0 {
+		address, _ = seeds[0].(string)
+	}
+	return reindex.RemoteHost(address, scheme, port)
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/reindex"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOpensearchWrapper_Reindex(t *testing.T) {
+	wrapper := testWrapper()
+	source, dest := "tc-reindex-source", "tc-reindex-dest"
+	assert.NoError(t, wrapper.CreateIndex(source))
+	t.Cleanup(func() {
+		_ = wrapper.DeleteIndex(source)
+		_ = wrapper.DeleteIndex(dest)
+	})
+	var docs bytes.Buffer
+	for n := 0; n < 20; n++ {
+		docs.WriteString(fmt.Sprintf("{\"index\":{\"_id\":\"%d\"}}\n{\"n\":%d,\"even\":%t}\n", n, n, n%2 == 0))
+	}
+	_, err := wrapper.Client.Do(t.Context(), opensearchapi.BulkReq{
+		Index:  source,
+		Body:   &docs,
+		Params: opensearchapi.BulkParams{Refresh: "true"},
+	}, nil)
+	assert.NoError(t, err)
+
+	taskID, err := wrapper.Reindex(reindex.Options{
+		Source:            []string{source},
+		Dest:              dest,
+		QueryString:       "even:true",
+		Slices:            reindex.SlicesAuto,
+		RequestsPerSecond: fp.AsPointer(1000),
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, taskID)
+
+	var status reindex.Status
+	assert.Eventually(t, func() bool {
+		result, err := wrapper.GetTask(taskID)
+		if err != nil || !result.Completed {
+			return false
+		}
+		return json.Unmarshal(result.Response, &status) == nil
+	}, time.Minute, time.Second)
+	assert.Equal(t, int64(10), status.Total)
+	assert.Equal(t, int64(10), status.Created)
+	assert.Empty(t, status.Failures)
+
+	_, err = wrapper.GetTask("missing:1")
+	assert.Error(t, err)
+	assert.Error(t, wrapper.CancelTask("missing:1"))
+}
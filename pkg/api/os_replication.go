@@ -6,127 +6,108 @@ import (
 	tstats "github.com/dalet-oss/opensearch-cli/pkg/api/types/stats"
 	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"sort"
+	"strings"
 )
 
 // CreateReplication creates the replication task
 // Initiate replication of an index from the leader cluster to the follower cluster. Send this request to the follower cluster.
-func (api *OpensearchWrapper) CreateReplication(opts replication.StartReplicationReq, raw bool) error {
+func (api *OpensearchWrapper) CreateReplication(opts replication.StartReplicationReq) (replication.AcknowledgedResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
-	var result interface{}
+	var result replication.AcknowledgedResponse
 	if rsp, err := api.Client.Do(ctx, opts, &result); err != nil {
-		return err
-	} else {
-		if rsp.IsError() {
-			return errors.New(printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return nil
-		} else {
-			log.Info().Msgf("create replication result:\n%s\n", printutils.MarshalJSONOrDie(result))
-		}
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// ListRemoteIndices returns the sorted names of the indices of the remote cluster connected with the alias
+// which match the pattern, the names are resolved by https://docs.opensearch.org/2.19/api-reference/index-apis/resolve-index/
+// on the local cluster and returned without the alias prefix.
+func (api *OpensearchWrapper) ListRemoteIndices(remoteAlias, pattern string) ([]string, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result opensearchapi.IndicesResolveResp
+	req := opensearchapi.IndicesResolveReq{Indices: []string{remoteAlias + ":" + pattern}}
+	if rsp, err := api.Client.Do(ctx, req, &result); err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	names := make([]string, 0, len(result.Indices))
+	for _, index := range result.Indices {
+		names = append(names, strings.TrimPrefix(index.Name, remoteAlias+":"))
 	}
-	return nil
+	sort.Strings(names)
+	return names, nil
 }
 
 // PauseReplication pauses the replication for the specified index in OpenSearch.
 // indexName specifies the name of the index whose replication is to be paused.
-// raw determines whether the raw response from the API call should be logged.
 // Returns an error if the API request fails or the response indicates an error.
-func (api *OpensearchWrapper) PauseReplication(indexName string, raw bool) error {
+func (api *OpensearchWrapper) PauseReplication(indexName string) (replication.AcknowledgedResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
-	var result interface{}
+	var result replication.AcknowledgedResponse
 	if rsp, err := api.Client.Do(ctx, replication.PauseReplicationReq{Index: indexName}, &result); err != nil {
-		return err
-	} else {
-		if rsp.IsError() {
-			return errors.New(printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return nil
-		} else {
-			log.Info().Msgf("pause replication result:\n%s\n", printutils.MarshalJSONOrDie(result))
-		}
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	return nil
+	return result, nil
 }
 
 // ResumeReplication resumes the replication for the specified index in OpenSearch.
 // indexName specifies the name of the index whose replication is to be resumed.
-// raw determines whether the raw response from the API call should be logged.
 // Returns an error if the API request fails or the response indicates an error.
-func (api *OpensearchWrapper) ResumeReplication(indexName string, raw bool) error {
+func (api *OpensearchWrapper) ResumeReplication(indexName string) (replication.AcknowledgedResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
-	var result interface{}
+	var result replication.AcknowledgedResponse
 	if rsp, err := api.Client.Do(ctx, replication.ResumeReplicationReq{Index: indexName}, &result); err != nil {
-		return err
-	} else {
-		if rsp.IsError() {
-			return errors.New(printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return nil
-		} else {
-			log.Info().Msgf("pause replication result:\n%s\n", printutils.MarshalJSONOrDie(result))
-		}
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	return nil
+	return result, nil
 }
 
 // StopReplication stops the replication process for the specified index in OpenSearch.
-// It optionally logs the raw response if the raw parameter is true. Returns an error if the operation fails.
-func (api *OpensearchWrapper) StopReplication(indexName string, raw bool) error {
+// Returns an error if the operation fails.
+func (api *OpensearchWrapper) StopReplication(indexName string) (replication.AcknowledgedResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
-	var result interface{}
+	var result replication.AcknowledgedResponse
 	if rsp, err := api.Client.Do(ctx, replication.StopReplicationReq{Index: indexName}, &result); err != nil {
-		return err
-	} else {
-		if rsp.IsError() {
-			return errors.New(printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return nil
-		} else {
-			log.Info().Msgf("stop replication result:\n%s\n", printutils.MarshalJSONOrDie(result))
-		}
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	return nil
+	return result, nil
 }
 
 // StatusReplication retrieves the replication status of a specified index in OpenSearch.
-// It fetches detailed replication statistics and logs the response based on verbosity.
+// It fetches detailed replication statistics.
 // Returns the IndexReplicationStatsResponse and an error, if any occurred during the operation.
-func (api *OpensearchWrapper) StatusReplication(indexName string, raw bool) (tstats.IndexReplicationStatsResponse, error) {
+func (api *OpensearchWrapper) StatusReplication(indexName string) (tstats.IndexReplicationStatsResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 	var result tstats.IndexReplicationStatsResponse
 	params := tstats.IndexReplicationStatsReq{Index: indexName, Params: tstats.IndexReplicationStatsParams{Verbose: true}}
 	if rsp, err := api.Client.Do(ctx, params, &result); err != nil {
 		return result, err
-	} else {
-		if rsp.IsError() {
-			return result, errors.New(printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return result, nil
-		} else {
-			log.Info().Msgf("replication status for index '%s':\n%s\n", indexName, printutils.MarshalJSONOrDie(result))
-		}
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
 	}
 	return result, nil
 }
 
-// TaskStatusReplication retrieves the recovery status of a specified index in OpenSearch with optional detailed and raw outputs.
-// It uses the CatRecovery API to fetch recovery details and logs the results based on the raw parameter.
-func (api *OpensearchWrapper) TaskStatusReplication(index string, detailed, raw bool) error {
+// TaskStatusReplication retrieves the recovery status of a specified index in OpenSearch with optional detailed output.
+// It uses the CatRecovery API to fetch recovery details.
+func (api *OpensearchWrapper) TaskStatusReplication(index string, detailed bool) (replication.RecoveryStatusResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 	query := opensearchapi.CatRecoveryReq{Params: opensearchapi.CatRecoveryParams{
@@ -138,19 +119,11 @@ func (api *OpensearchWrapper) TaskStatusReplication(index string, detailed, raw
 	if len(index) > 0 {
 		query.Indices = []string{index}
 	}
-	var result []opensearchapi.CatRecoveryItemResp
+	var result replication.RecoveryStatusResponse
 	if rsp, err := api.Client.Do(ctx, query, &result); err != nil {
-		return err
-	} else {
-		if rsp.IsError() {
-			return errors.New(printutils.RawResponse(rsp))
-		}
-		if raw {
-			log.Info().Msg(printutils.RawResponse(rsp))
-			return nil
-		} else {
-			log.Info().Msgf("recovery status:\n%s", printutils.MarshalJSONOrDie(result))
-		}
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	return nil
+	return result, nil
 }
@@ -68,14 +68,14 @@ func TestOpensearchWrapper_CreateReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]configured]")
 			},
 			CaseInput: getStartReplicationQuery(replicatedIndexName),
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
 				assert.NoError(t, c.DeleteIndex(replicatedIndexName))
 				t.Log("[follower]cleaned up")
 			},
@@ -99,7 +99,7 @@ func TestOpensearchWrapper_CreateReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]configured]")
 			},
 			CaseInput: replication.StartReplicationReq{
@@ -112,7 +112,7 @@ func TestOpensearchWrapper_CreateReplication(t *testing.T) {
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
 				t.Log("[follower]cleaned up")
 			},
 		},
@@ -140,7 +140,7 @@ func TestOpensearchWrapper_CreateReplication(t *testing.T) {
 			}
 			t.Log("configured")
 			// actual test
-			executionError := tt.Wrapper.CreateReplication(tt.CaseInput.(replication.StartReplicationReq), false)
+			_, executionError := tt.Wrapper.CreateReplication(tt.CaseInput.(replication.StartReplicationReq))
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
@@ -179,17 +179,17 @@ func TestOpensearchWrapper_PauseReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]creating replication task")
-				assert.NoError(t, c.CreateReplication(getStartReplicationQuery(replicatedIndexName), true))
+				assert.NoError(t, noResult(c.CreateReplication(getStartReplicationQuery(replicatedIndexName))))
 				t.Log("[follower]configured]")
 			},
 			CaseInput: replicatedIndexName,
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
-				if err := c.StopReplication(replicatedIndexName, true); err != nil {
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
+				if _, err := c.StopReplication(replicatedIndexName); err != nil {
 					t.Log("[follower]failed to stop replication")
 				}
 				assert.NoError(t, c.DeleteIndex(replicatedIndexName))
@@ -214,14 +214,14 @@ func TestOpensearchWrapper_PauseReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]configured]")
 			},
 			CaseInput: replicatedIndexName,
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
 				t.Log("[follower]cleaned up")
 			},
 			PostLeaderFunc: func(t *testing.T, c *OpensearchWrapper) {
@@ -255,12 +255,12 @@ func TestOpensearchWrapper_PauseReplication(t *testing.T) {
 			}
 			t.Log("configured")
 			// actual test
-			executionError := tt.Wrapper.PauseReplication(tt.CaseInput.(string), true)
+			_, executionError := tt.Wrapper.PauseReplication(tt.CaseInput.(string))
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
 				assert.NoError(t, executionError, "expected to get no error")
-				status, replStatusErr := tt.Wrapper.StatusReplication(tt.CaseInput.(string), true)
+				status, replStatusErr := tt.Wrapper.StatusReplication(tt.CaseInput.(string))
 				assert.NoError(t, replStatusErr, "expected to get no error")
 				assert.Contains(t, status.Status, "PAUSED", "expected status to contain paused")
 			}
@@ -299,14 +299,14 @@ func TestOpensearchWrapper_ResumeReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]creating replication task")
-				assert.NoError(t, c.CreateReplication(getStartReplicationQuery(replicatedIndexName), true))
+				assert.NoError(t, noResult(c.CreateReplication(getStartReplicationQuery(replicatedIndexName))))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower] pausing replication")
-				pauseErr := c.PauseReplication(replicatedIndexName, true)
+				_, pauseErr := c.PauseReplication(replicatedIndexName)
 				assert.NoError(t, pauseErr, "expected to pause replication")
-				replicationTaskStatus, statusQueryErr := c.StatusReplication(replicatedIndexName, true)
+				replicationTaskStatus, statusQueryErr := c.StatusReplication(replicatedIndexName)
 				assert.NoError(t, statusQueryErr, "expected to get no error")
 				assert.Equal(t, replicationTaskStatus.Status, "PAUSED", "expected status PAUSED")
 				t.Log("[follower]configured]")
@@ -315,8 +315,8 @@ func TestOpensearchWrapper_ResumeReplication(t *testing.T) {
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
-				if err := c.StopReplication(replicatedIndexName, true); err != nil {
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
+				if _, err := c.StopReplication(replicatedIndexName); err != nil {
 					t.Log("[follower]failed to stop replication")
 				}
 				assert.NoError(t, c.DeleteIndex(replicatedIndexName))
@@ -341,9 +341,9 @@ func TestOpensearchWrapper_ResumeReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]creating replication task")
-				assert.NoError(t, c.CreateReplication(getStartReplicationQuery(replicatedIndexName), true))
+				assert.NoError(t, noResult(c.CreateReplication(getStartReplicationQuery(replicatedIndexName))))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower]configured]")
 			},
@@ -351,8 +351,8 @@ func TestOpensearchWrapper_ResumeReplication(t *testing.T) {
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
-				if err := c.StopReplication(replicatedIndexName, true); err != nil {
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
+				if _, err := c.StopReplication(replicatedIndexName); err != nil {
 					t.Log("[follower]failed to stop replication")
 				}
 				assert.NoError(t, c.DeleteIndex(replicatedIndexName))
@@ -389,12 +389,12 @@ func TestOpensearchWrapper_ResumeReplication(t *testing.T) {
 			}
 			t.Log("configured")
 			// actual test
-			executionError := tt.Wrapper.ResumeReplication(tt.CaseInput.(string), true)
+			_, executionError := tt.Wrapper.ResumeReplication(tt.CaseInput.(string))
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
 				assert.NoError(t, executionError, "expected to get no error")
-				replicationTaskStatus, statusQueryErr := tt.Wrapper.StatusReplication(tt.CaseInput.(string), true)
+				replicationTaskStatus, statusQueryErr := tt.Wrapper.StatusReplication(tt.CaseInput.(string))
 				assert.NoError(t, statusQueryErr, "expected to get no error")
 				assert.Equal(t, replicationTaskStatus.Status, "SYNCING", "expected status to contain paused")
 			}
@@ -431,9 +431,9 @@ func TestOpensearchWrapper_StatusReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]creating replication task")
-				assert.NoError(t, c.CreateReplication(getStartReplicationQuery(replicatedIndexName), true))
+				assert.NoError(t, noResult(c.CreateReplication(getStartReplicationQuery(replicatedIndexName))))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower]configured]")
 			},
@@ -441,8 +441,8 @@ func TestOpensearchWrapper_StatusReplication(t *testing.T) {
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
-				if err := c.StopReplication(replicatedIndexName, true); err != nil {
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
+				if _, err := c.StopReplication(replicatedIndexName); err != nil {
 					t.Log("[follower]failed to stop replication")
 				}
 				assert.NoError(t, c.DeleteIndex(replicatedIndexName))
@@ -501,7 +501,7 @@ func TestOpensearchWrapper_StatusReplication(t *testing.T) {
 			}
 			t.Log("configured")
 			// actual test
-			replicationStatus, executionError := tt.Wrapper.StatusReplication(tt.CaseInput.(string), true)
+			replicationStatus, executionError := tt.Wrapper.StatusReplication(tt.CaseInput.(string))
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
@@ -542,9 +542,9 @@ func TestOpensearchWrapper_StopReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]creating replication task")
-				assert.NoError(t, c.CreateReplication(getStartReplicationQuery(replicatedIndexName), true))
+				assert.NoError(t, noResult(c.CreateReplication(getStartReplicationQuery(replicatedIndexName))))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower]configured]")
 			},
@@ -552,7 +552,7 @@ func TestOpensearchWrapper_StopReplication(t *testing.T) {
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
 				assert.NoError(t, c.DeleteIndex(replicatedIndexName))
 				t.Log("[follower]cleaned up")
 			},
@@ -586,14 +586,14 @@ func TestOpensearchWrapper_StopReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
 				t.Log("[follower]creating replication task")
-				assert.NoError(t, c.CreateReplication(getStartReplicationQuery(replicatedIndexName), true))
+				assert.NoError(t, noResult(c.CreateReplication(getStartReplicationQuery(replicatedIndexName))))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower] stopping replication")
-				stopErr := c.StopReplication(replicatedIndexName, true)
+				_, stopErr := c.StopReplication(replicatedIndexName)
 				assert.NoError(t, stopErr, "expected to stop replication")
-				replicationTaskStatus, statusQueryErr := c.StatusReplication(replicatedIndexName, true)
+				replicationTaskStatus, statusQueryErr := c.StatusReplication(replicatedIndexName)
 				assert.NoError(t, statusQueryErr, "expected to get no error")
 				assert.Equal(t, replicationTaskStatus.Status, "REPLICATION NOT IN PROGRESS", "expected status REPLICATION NOT IN PROGRESS")
 				t.Log("[follower]configured]")
@@ -602,7 +602,7 @@ func TestOpensearchWrapper_StopReplication(t *testing.T) {
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
 				assert.NoError(t, c.DeleteIndex(replicatedIndexName))
 				t.Log("[follower]cleaned up")
 			},
@@ -637,7 +637,7 @@ func TestOpensearchWrapper_StopReplication(t *testing.T) {
 			}
 			t.Log("configured")
 			// actual test
-			executionError := tt.Wrapper.StopReplication(tt.CaseInput.(string), true)
+			_, executionError := tt.Wrapper.StopReplication(tt.CaseInput.(string))
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
@@ -665,17 +665,17 @@ func TestOpensearchWrapper_TaskStatusReplication(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getCCR(), true), "expected to configure remote cluster")
-				assert.NoError(t, c.CreateReplication(getStartReplicationQuery(replicatedIndexName), true))
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.CreateReplication(getStartReplicationQuery(replicatedIndexName))))
 				t.Log("[follower]configured]")
 			},
 			CaseInput: replicatedIndexName,
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]cleaning up")
-				assert.NoError(t, c.StopReplication(replicatedIndexName, true))
+				assert.NoError(t, noResult(c.StopReplication(replicatedIndexName)))
 				assert.NoError(t, c.DeleteIndex(replicatedIndexName))
-				assert.NoError(t, c.DeleteRemote(getCCR().RemoteName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(getCCR().RemoteName)))
 				t.Log("[follower]cleaned up")
 			},
 			PostLeaderFunc: func(t *testing.T, c *OpensearchWrapper) {
@@ -721,7 +721,7 @@ func TestOpensearchWrapper_TaskStatusReplication(t *testing.T) {
 			}
 			t.Log("configured")
 			// actual test
-			executionError := tt.Wrapper.TaskStatusReplication(tt.CaseInput.(string), true, true)
+			_, executionError := tt.Wrapper.TaskStatusReplication(tt.CaseInput.(string), true)
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
@@ -730,3 +730,80 @@ func TestOpensearchWrapper_TaskStatusReplication(t *testing.T) {
 		})
 	}
 }
+
+// TestOpensearchWrapper_ListRemoteIndices tests listing of the leader indices through the remote cluster connection.
+func TestOpensearchWrapper_ListRemoteIndices(t *testing.T) {
+	leaderIndices := []string{"remote-list-test-index-2", "remote-list-test-index-1"}
+	leader := wrapperForContainer(LeaderContainer)
+	follower := wrapperForContainer(MainContainer)
+	for _, index := range leaderIndices {
+		preConfigureOSIndex(t, leader, leaderOsInst, index)
+	}
+	assert.NoError(t, noResult(follower.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
+	t.Cleanup(func() {
+		assert.NoError(t, noResult(follower.DeleteRemote(getCCR().RemoteName)))
+		for _, index := range leaderIndices {
+			assert.NoError(t, leader.DeleteIndex(index))
+		}
+	})
+
+	names, err := follower.ListRemoteIndices(getCCR().RemoteName, "remote-list-test-*")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"remote-list-test-index-1", "remote-list-test-index-2"}, names)
+
+	names, err = follower.ListRemoteIndices(getCCR().RemoteName, "remote-list-test-none-*")
+	assert.NoError(t, err)
+	assert.Empty(t, names)
+}
+
+// TestOpensearchWrapper_ReplicationOperationsOfIndices tests pausing, resuming and stopping the replication
+// of several follower indices, as the replication commands do for the index pattern.
+func TestOpensearchWrapper_ReplicationOperationsOfIndices(t *testing.T) {
+	replicatedIndices := []string{"bulk-operations-test-index-1", "bulk-operations-test-index-2"}
+	leader := wrapperForContainer(LeaderContainer)
+	follower := wrapperForContainer(MainContainer)
+	for _, index := range replicatedIndices {
+		preConfigureOSIndex(t, leader, leaderOsInst, index)
+	}
+	assert.NoError(t, noResult(follower.ConfigureRemoteCluster(getCCR())), "expected to configure remote cluster")
+	t.Cleanup(func() {
+		for _, index := range replicatedIndices {
+			_, _ = follower.StopReplication(index)
+			_ = follower.DeleteIndex(index)
+			assert.NoError(t, leader.DeleteIndex(index))
+		}
+		assert.NoError(t, noResult(follower.DeleteRemote(getCCR().RemoteName)))
+	})
+	for _, index := range replicatedIndices {
+		assert.NoError(t, noResult(follower.CreateReplication(getStartReplicationQuery(index))))
+	}
+
+	operations := []struct {
+		name   string
+		apply  func(index string) (replication.AcknowledgedResponse, error)
+		status string
+	}{
+		{name: "pause", apply: follower.PauseReplication, status: "PAUSED"},
+		{name: "resume", apply: follower.ResumeReplication, status: "SYNCING"},
+	}
+	for _, operation := range operations {
+		for _, index := range replicatedIndices {
+			rsp, err := operation.apply(index)
+			assert.NoError(t, err, "expected to %s replication of %s", operation.name, index)
+			assert.True(t, rsp.Acknowledged)
+		}
+		for _, index := range replicatedIndices {
+			assert.Eventually(t, func() bool {
+				status, err := follower.StatusReplication(index)
+				return err == nil && status.Status == operation.status
+			}, time.Minute, time.Second, "expected %s status of %s", operation.status, index)
+		}
+	}
+	for _, index := range replicatedIndices {
+		rsp, err := follower.StopReplication(index)
+		assert.NoError(t, err, "expected to stop replication of %s", index)
+		assert.True(t, rsp.Acknowledged)
+		_, err = follower.PauseReplication(index)
+		assert.Error(t, err, "stopped replication of %s can't be paused", index)
+	}
+}
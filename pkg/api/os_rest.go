@@ -0,0 +1,59 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RestResponse holds the response of the arbitrary REST request.
+type RestResponse struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+}
+
+// IsSuccess returns true if the response status code is 2xx.
+func (r RestResponse) IsSuccess() bool {
+	return r.StatusCode >= http.StatusOK && r.StatusCode < http.StatusMultipleChoices
+}
+
+// Rest sends the arbitrary request to the cluster through the authenticated client of the context.
+// The path may contain the query string, the query values are added to it.
+// The response with any status code is returned, the error is returned only if no response was received.
+func (api *OpensearchWrapper) Rest(method, path string, query url.Values, header http.Header, body io.Reader) (RestResponse, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	target, err := url.Parse(path)
+	if err != nil {
+		return RestResponse{}, fmt.Errorf("invalid path '%s':%w", path, err)
+	}
+	values := target.Query()
+	for k, v := range query {
+		values[k] = append(values[k], v...)
+	}
+	target.RawQuery = values.Encode()
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(method), target.String(), body)
+	if err != nil {
+		return RestResponse{}, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rsp, err := api.Client.Perform(req)
+	if err != nil {
+		return RestResponse{}, err
+	}
+	defer rsp.Body.Close()
+	rspBody, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return RestResponse{}, fmt.Errorf("unable to read response body:%w", err)
+	}
+	return RestResponse{StatusCode: rsp.StatusCode, Status: rsp.Status, Header: rsp.Header, Body: rspBody}, nil
+}
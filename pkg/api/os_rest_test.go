@@ -0,0 +1,67 @@
+package api
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestOpensearchWrapper_Rest(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		query          url.Values
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "cat with query from path and values",
+			method:         http.MethodGet,
+			path:           "_cat/indices?format=json",
+			query:          url.Values{"index": []string{"tc-rest"}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "create index with body",
+			method:         http.MethodPut,
+			path:           "/tc-rest",
+			body:           `{"settings":{"number_of_replicas":0}}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"acknowledged":true`,
+		},
+		{
+			name:           "missing index is returned as the response",
+			method:         http.MethodGet,
+			path:           "/tc-rest-missing/_settings",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "index_not_found_exception",
+		},
+		{
+			name:           "delete index",
+			method:         "delete",
+			path:           "/tc-rest",
+			expectedStatus: http.StatusOK,
+		},
+	}
+	wrapper := testWrapper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			var body io.Reader
+			if tt.body != "" {
+				header.Set("Content-Type", "application/json")
+				body = strings.NewReader(tt.body)
+			}
+			rsp, err := wrapper.Rest(tt.method, tt.path, tt.query, header, body)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rsp.StatusCode)
+			assert.Equal(t, tt.expectedStatus < http.StatusMultipleChoices, rsp.IsSuccess())
+			assert.Contains(t, string(rsp.Body), tt.expectedBody)
+		})
+	}
+}
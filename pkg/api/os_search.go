@@ -0,0 +1,194 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/search"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"slices"
+	"strings"
+)
+
+// ResolveIndices expands the comma separated list of index names and wildcard patterns to the list of existing indices.
+// The names without wildcard are kept as is, so the aliases and data streams can be used too.
+func (api *OpensearchWrapper) ResolveIndices(expression string) ([]string, error) {
+	var resolved, indexNames []string
+	for _, name := range strings.Split(expression, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !gu.ContainsWildcard(name) {
+			resolved = append(resolved, name)
+			continue
+		}
+		if indexNames == nil {
+			registeredIndices, err := api.GetIndexList()
+			if err != nil {
+				return nil, err
+			}
+			indexNames = fp.Map(registeredIndices, func(info IndexInfo) string {
+				return info.Index
+			})
+		}
+		resolved = append(resolved, fp.Filter(indexNames, gu.GetMatchFunc(name))...)
+	}
+	slices.Sort(resolved)
+	resolved = slices.Compact(resolved)
+	if len(resolved) == 0 {
+		return nil, fmt.Errorf("no indices found for '%s' expression", expression)
+	}
+	return resolved, nil
+}
+
+// Search runs the search on the indices and passes the hits to the handler in the order they are returned.
+// The hits which don't fit in the single page are fetched with the scroll or the point in time pagination,
+// the scroll or the point in time is released when the search is finished.
+func (api *OpensearchWrapper) Search(indices []string, opts search.Options, handle func(search.Hit) error) (search.Summary, error) {
+	summary := search.Summary{}
+	pageSize := opts.Size
+	if opts.NeedsPagination() {
+		pageSize = opts.PageSizeOrDefault()
+	}
+	body, err := opts.BuildBody(pageSize)
+	if err != nil {
+		return summary, err
+	}
+	// consume passes the page hits to the handler, returns false when the search is done
+	consume := func(rsp search.Response) (bool, error) {
+		summary.Total, summary.Relation = rsp.Hits.Total.Value, rsp.Hits.Total.Relation
+		for _, hit := range rsp.Hits.Hits {
+			if opts.Size >= 0 && summary.Returned >= opts.Size {
+				return false, nil
+			}
+			if err := handle(hit); err != nil {
+				return false, err
+			}
+			summary.Returned++
+		}
+		return len(rsp.Hits.Hits) == pageSize && (opts.Size < 0 || summary.Returned < opts.Size), nil
+	}
+	switch {
+	case !opts.NeedsPagination():
+		rsp, err := api.searchPage(opensearchapi.SearchReq{Indices: indices}, body)
+		if err != nil {
+			return summary, err
+		}
+		_, err = consume(rsp)
+		return summary, err
+	case opts.Paginate == search.PaginatePIT:
+		return summary, api.searchPIT(indices, body, consume)
+	default:
+		return summary, api.searchScroll(indices, body, consume)
+	}
+}
+
+// searchScroll fetches the pages with the scroll API until consume returns false.
+func (api *OpensearchWrapper) searchScroll(indices []string, body map[string]any, consume func(search.Response) (bool, error)) error {
+	rsp, err := api.searchPage(opensearchapi.SearchReq{
+		Indices: indices,
+		Params:  opensearchapi.SearchParams{Scroll: search.DefaultKeepAlive},
+	}, body)
+	if err != nil {
+		return err
+	}
+	scrollID := rsp.ScrollID
+	defer func() {
+		if scrollID != "" {
+			api.releaseSearchContext(opensearchapi.ScrollDeleteReq{ScrollIDs: []string{scrollID}})
+		}
+	}()
+	for {
+		more, consumeErr := consume(rsp)
+		if consumeErr != nil || !more {
+			return consumeErr
+		}
+		ctx, cancelFunc := api.requestContext()
+		rsp = search.Response{}
+		httpRsp, err := api.Client.Do(ctx, opensearchapi.ScrollGetReq{
+			ScrollID: scrollID,
+			Params:   opensearchapi.ScrollGetParams{Scroll: search.DefaultKeepAlive},
+		}, &rsp)
+		cancelFunc()
+		if err != nil {
+			return err
+		} else if httpRsp.IsError() {
+			return errors.New(printutils.RawResponse(httpRsp))
+		}
+		if rsp.ScrollID != "" {
+			scrollID = rsp.ScrollID
+		}
+	}
+}
+
+// searchPIT fetches the pages with search_after on the point in time until consume returns false.
+func (api *OpensearchWrapper) searchPIT(indices []string, body map[string]any, consume func(search.Response) (bool, error)) error {
+	if _, sorted := body["sort"]; !sorted {
+		return fmt.Errorf("%s pagination requires the sort ending with the unique field", search.PaginatePIT)
+	}
+	ctx, cancelFunc := api.requestContext()
+	var pit opensearchapi.PointInTimeCreateResp
+	httpRsp, err := api.Client.Do(ctx, opensearchapi.PointInTimeCreateReq{
+		Indices: indices,
+		Params:  opensearchapi.PointInTimeCreateParams{KeepAlive: search.DefaultKeepAlive},
+	}, &pit)
+	cancelFunc()
+	if err != nil {
+		return err
+	} else if httpRsp.IsError() {
+		return errors.New(printutils.RawResponse(httpRsp))
+	}
+	defer api.releaseSearchContext(opensearchapi.PointInTimeDeleteReq{PitID: []string{pit.PitID}})
+	pitID := pit.PitID
+	for {
+		body["pit"] = map[string]any{"id": pitID, "keep_alive": fmt.Sprintf("%dms", search.DefaultKeepAlive.Milliseconds())}
+		rsp, err := api.searchPage(opensearchapi.SearchReq{}, body)
+		if err != nil {
+			return err
+		}
+		more, consumeErr := consume(rsp)
+		if consumeErr != nil || !more || len(rsp.Hits.Hits) == 0 {
+			return consumeErr
+		}
+		body["search_after"] = rsp.Hits.Hits[len(rsp.Hits.Hits)-1].Sort
+		if rsp.PitID != "" {
+			pitID = rsp.PitID
+		}
+	}
+}
+
+// searchPage sends the single search request with the body.
+func (api *OpensearchWrapper) searchPage(req opensearchapi.SearchReq, body map[string]any) (search.Response, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result search.Response
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return result, err
+	}
+	req.Body = bytes.NewReader(bodyBytes)
+	if rsp, err := api.Client.Do(ctx, req, &result); err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// releaseSearchContext deletes the scroll or the point in time, the failure is only logged as they expire anyway.
+func (api *OpensearchWrapper) releaseSearchContext(req opensearch.Request) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	if rsp, err := api.Client.Do(ctx, req, &result); err != nil {
+		log.Warn().Msgf("unable to release search context:%v", err)
+	} else if rsp.IsError() {
+		log.Warn().Msgf("unable to release search context:%s", printutils.RawResponse(rsp))
+	}
+}
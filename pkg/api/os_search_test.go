@@ -0,0 +1,73 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/search"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOpensearchWrapper_Search(t *testing.T) {
+	wrapper := testWrapper()
+	indices := []string{"tc-search-a", "tc-search-b"}
+	for i, index := range indices {
+		assert.NoError(t, wrapper.CreateIndex(index))
+		var bulk bytes.Buffer
+		for n := 0; n < 15; n++ {
+			doc, _ := json.Marshal(map[string]any{"n": i*100 + n, "even": n%2 == 0})
+			bulk.WriteString(fmt.Sprintf("{\"index\":{\"_id\":\"%d\"}}\n%s\n", i*100+n, doc))
+		}
+		_, err := wrapper.Client.Do(t.Context(), opensearchapi.BulkReq{
+			Index:  index,
+			Body:   &bulk,
+			Params: opensearchapi.BulkParams{Refresh: "true"},
+		}, nil)
+		assert.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		for _, index := range indices {
+			_ = wrapper.DeleteIndex(index)
+		}
+	})
+
+	resolved, err := wrapper.ResolveIndices("tc-search-*,tc-search-a")
+	assert.NoError(t, err)
+	assert.Equal(t, indices, resolved)
+	_, err = wrapper.ResolveIndices("tc-search-missing-*")
+	assert.Error(t, err)
+
+	tests := []struct {
+		name             string
+		opts             search.Options
+		expectedReturned int
+		expectedTotal    int
+	}{
+		{name: "single page", opts: search.Options{Size: 5}, expectedReturned: 5, expectedTotal: 30},
+		{name: "query string", opts: search.Options{Query: "even:true", Size: 100}, expectedReturned: 16, expectedTotal: 16},
+		{name: "scroll all", opts: search.Options{Size: -1, PageSize: 7}, expectedReturned: 30, expectedTotal: 30},
+		{name: "scroll limited", opts: search.Options{Size: 12, PageSize: 5}, expectedReturned: 12, expectedTotal: 30},
+		{
+			name:             "pit all",
+			opts:             search.Options{Size: -1, PageSize: 4, Paginate: search.PaginatePIT, Sort: []string{"n:asc"}},
+			expectedReturned: 30,
+			expectedTotal:    30,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := map[string]bool{}
+			summary, err := wrapper.Search(resolved, tt.opts, func(hit search.Hit) error {
+				assert.False(t, seen[hit.ID], "hit '%s' is returned twice", hit.ID)
+				seen[hit.ID] = true
+				return nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedReturned, summary.Returned)
+			assert.Equal(t, tt.expectedTotal, summary.Total)
+			assert.Len(t, seen, tt.expectedReturned)
+		})
+	}
+}
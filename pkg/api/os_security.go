@@ -0,0 +1,122 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/security"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"maps"
+	"net/http"
+)
+
+// GetSecurityResources returns the security resources of the kind with the name compliant with the pattern,
+// all resources if it is empty, the result is one of security.Users, security.Roles, security.RoleMappings
+// or security.ActionGroups.
+func (api *OpensearchWrapper) GetSecurityResources(kind security.Kind, pattern string) (any, error) {
+	switch kind {
+	case security.KindUser:
+		return getSecurityResources[security.Users](api, kind, pattern)
+	case security.KindRole:
+		return getSecurityResources[security.Roles](api, kind, pattern)
+	case security.KindRoleMapping:
+		return getSecurityResources[security.RoleMappings](api, kind, pattern)
+	case security.KindActionGroup:
+		return getSecurityResources[security.ActionGroups](api, kind, pattern)
+	}
+	return nil, fmt.Errorf("unknown security resource kind '%s'", kind)
+}
+
+// getSecurityResources requests all resources of the kind and filters them by the pattern,
+// the security API doesn't support the wildcards.
+func getSecurityResources[M ~map[string]V, V any](api *OpensearchWrapper, kind security.Kind, pattern string) (M, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	result := M{}
+	rsp, err := api.Client.Do(ctx, security.GetResourceReq{Kind: kind}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	if pattern != "" {
+		match := gu.GetMatchFunc(pattern)
+		maps.DeleteFunc(result, func(name string, _ V) bool {
+			return !match(name)
+		})
+	}
+	return result, nil
+}
+
+// GetSecurityResource returns the definition of the security resource, it is nil if the resource doesn't exist.
+func (api *OpensearchWrapper) GetSecurityResource(kind security.Kind, name string) (json.RawMessage, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result map[string]json.RawMessage
+	rsp, err := api.Client.Do(ctx, security.GetResourceReq{Kind: kind, Name: name}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result[name], nil
+}
+
+// PutSecurityResource creates or replaces the security resource, see security.Definition.
+func (api *OpensearchWrapper) PutSecurityResource(kind security.Kind, name string, body []byte) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, security.PutResourceReq{Kind: kind, Name: name, Body: body}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// DeleteSecurityResource deletes the security resource, the reserved resources can't be deleted.
+func (api *OpensearchWrapper) DeleteSecurityResource(kind security.Kind, name string) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, security.DeleteResourceReq{Kind: kind, Name: name}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// AuthInfo returns the user the requests are authenticated as, with the backend roles, the mapped roles and the tenants.
+func (api *OpensearchWrapper) AuthInfo() (security.AuthInfo, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result security.AuthInfo
+	rsp, err := api.Client.Do(ctx, security.AuthInfoReq{}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// MapRoleUsers adds the users to the mapping of the role keeping the users, the backend roles and the hosts
+// which are already mapped, the mapping is created if it doesn't exist.
+func (api *OpensearchWrapper) MapRoleUsers(role string, users []string) error {
+	existing, err := api.GetSecurityResource(security.KindRoleMapping, role)
+	if err != nil {
+		return err
+	}
+	body, err := security.MapUsers(existing, users)
+	if err != nil {
+		return err
+	}
+	return api.PutSecurityResource(security.KindRoleMapping, role, body)
+}
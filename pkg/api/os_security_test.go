@@ -0,0 +1,175 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/security"
+	"github.com/stretchr/testify/assert"
+	"maps"
+	"slices"
+	"testing"
+)
+
+// securityCase is the input of the security resource round-trip test cases.
+type securityCase struct {
+	Kind security.Kind
+	Name string
+	Body string
+	// Field is the field of the definition expected in the get response.
+	Field string
+}
+
+// skipWithoutSecurity skips the test if the security plugin is disabled in the test container.
+func skipWithoutSecurity(t *testing.T) {
+	if _, err := testWrapper().AuthInfo(); err != nil {
+		t.Skipf("security plugin is not enabled:%v", err)
+	}
+}
+
+// securityRoundTrip creates the resource, gets it by the name and by the pattern and deletes it.
+func securityRoundTrip(t *testing.T, c *OpensearchWrapper, input securityCase) error {
+	if err := c.PutSecurityResource(input.Kind, input.Name, []byte(input.Body)); err != nil {
+		return err
+	}
+	definition, err := c.GetSecurityResource(input.Kind, input.Name)
+	if err != nil {
+		return err
+	}
+	var fields map[string]any
+	if assert.NotNil(t, definition, "expected to get the created resource") {
+		assert.NoError(t, json.Unmarshal(definition, &fields))
+		assert.Contains(t, fields, input.Field)
+	}
+	listed, err := c.GetSecurityResources(input.Kind, input.Name)
+	if err != nil {
+		return err
+	}
+	var names []string
+	switch resources := listed.(type) {
+	case security.Users:
+		names = slices.Collect(maps.Keys(resources))
+	case security.Roles:
+		names = slices.Collect(maps.Keys(resources))
+	case security.RoleMappings:
+		names = slices.Collect(maps.Keys(resources))
+	}
+	assert.Equal(t, []string{input.Name}, names)
+	if err := c.DeleteSecurityResource(input.Kind, input.Name); err != nil {
+		return err
+	}
+	definition, err = c.GetSecurityResource(input.Kind, input.Name)
+	assert.Nil(t, definition, "expected the deleted resource to be missing")
+	return err
+}
+
+func TestOpensearchWrapper_SecurityResources(t *testing.T) {
+	skipWithoutSecurity(t)
+	const roleName = "tc-security-role"
+	createRole := func(t *testing.T, c *OpensearchWrapper) {
+		assert.NoError(t, c.PutSecurityResource(security.KindRole, roleName, []byte(`{"cluster_permissions":["cluster_monitor"]}`)))
+	}
+	deleteRole := func(t *testing.T, c *OpensearchWrapper) {
+		assert.NoError(t, c.DeleteSecurityResource(security.KindRole, roleName))
+	}
+	tests := []OSSingleContainerTest{
+		{
+			Name:    "user round trip",
+			Wrapper: testWrapper(),
+			CaseInput: securityCase{
+				Kind:  security.KindUser,
+				Name:  "tc-security-user",
+				Body:  `{"password":"Tc-Secur1ty!Passw0rd","backend_roles":["tc-backend"]}`,
+				Field: "backend_roles",
+			},
+		},
+		{
+			Name:    "user with the slash in name round trip",
+			Wrapper: testWrapper(),
+			CaseInput: securityCase{
+				Kind:  security.KindUser,
+				Name:  "tc-security/ci",
+				Body:  `{"password":"Tc-Secur1ty!Passw0rd"}`,
+				Field: "backend_roles",
+			},
+		},
+		{
+			Name:    "user with weak password",
+			Wrapper: testWrapper(),
+			CaseInput: securityCase{
+				Kind: security.KindUser,
+				Name: "tc-security-weak",
+				Body: `{"password":"a"}`,
+			},
+			WantErr: true,
+		},
+		{
+			Name:    "role round trip",
+			Wrapper: testWrapper(),
+			CaseInput: securityCase{
+				Kind:  security.KindRole,
+				Name:  roleName,
+				Body:  `{"cluster_permissions":["cluster_monitor"],"index_permissions":[{"index_patterns":["tc-*"],"allowed_actions":["read"]}]}`,
+				Field: "index_permissions",
+			},
+		},
+		{
+			Name:    "role with unknown field",
+			Wrapper: testWrapper(),
+			CaseInput: securityCase{
+				Kind: security.KindRole,
+				Name: "tc-security-invalid",
+				Body: `{"unknown_permissions":["read"]}`,
+			},
+			WantErr: true,
+		},
+		{
+			Name:    "role mapping round trip",
+			Wrapper: testWrapper(),
+			CaseInput: securityCase{
+				Kind:  security.KindRoleMapping,
+				Name:  roleName,
+				Body:  `{"users":["tc-security-user"],"backend_roles":["tc-backend"]}`,
+				Field: "users",
+			},
+			ConfigureFunc: createRole,
+			PostFunc:      deleteRole,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if tt.PostFunc != nil {
+					tt.PostFunc(t, tt.Wrapper)
+				}
+			})
+			if tt.ConfigureFunc != nil {
+				tt.ConfigureFunc(t, tt.Wrapper)
+			}
+			executionErr := securityRoundTrip(t, tt.Wrapper, tt.CaseInput.(securityCase))
+			if tt.WantErr {
+				assert.Error(t, executionErr, "expected to get error")
+			} else {
+				assert.NoError(t, executionErr, "expected to get no error")
+			}
+		})
+	}
+}
+
+func TestOpensearchWrapper_MapRoleUsers(t *testing.T) {
+	skipWithoutSecurity(t)
+	wrapper := testWrapper()
+	const roleName = "tc-security-mapped-role"
+	assert.NoError(t, wrapper.PutSecurityResource(security.KindRole, roleName, []byte(`{"cluster_permissions":["cluster_monitor"]}`)))
+	t.Cleanup(func() {
+		_ = wrapper.DeleteSecurityResource(security.KindRoleMapping, roleName)
+		_ = wrapper.DeleteSecurityResource(security.KindRole, roleName)
+	})
+	assert.NoError(t, wrapper.MapRoleUsers(roleName, []string{"tc-a"}))
+	assert.NoError(t, wrapper.MapRoleUsers(roleName, []string{"tc-b", "tc-a"}))
+	definition, err := wrapper.GetSecurityResource(security.KindRoleMapping, roleName)
+	assert.NoError(t, err)
+	var mapping struct {
+		Users []string `json:"users"`
+	}
+	assert.NoError(t, json.Unmarshal(definition, &mapping))
+	assert.ElementsMatch(t, []string{"tc-a", "tc-b"}, mapping.Users, "existing users are kept")
+}
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/snapshots"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"net/http"
+	"time"
+)
+
+// GetRepositories returns the snapshot repositories matching the pattern, all repositories if it is empty.
+func (api *OpensearchWrapper) GetRepositories(pattern string) (snapshots.Repositories, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	req := opensearchapi.SnapshotRepositoryGetReq{}
+	if pattern != "" {
+		req.Repos = []string{pattern}
+	}
+	result := snapshots.Repositories{}
+	rsp, err := api.Client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// CreateRepository registers or updates the snapshot repository, see snapshots.RepositoryBody,
+// verify checks the repository is accessible by all nodes before it is registered.
+func (api *OpensearchWrapper) CreateRepository(name string, body map[string]any, verify bool) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SnapshotRepositoryCreateReq{
+		Repo:   name,
+		Body:   bytes.NewReader(printutils.MarshalJSONOrDie(body)),
+		Params: opensearchapi.SnapshotRepositoryCreateParams{Verify: fp.AsPointer(verify)},
+	}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// VerifyRepository checks the repository is accessible by the nodes, returns the nodes which verified it.
+func (api *OpensearchWrapper) VerifyRepository(name string) (snapshots.Verification, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result snapshots.Verification
+	rsp, err := api.Client.Do(ctx, opensearchapi.SnapshotRepositoryVerifyReq{Repo: name}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// DeleteRepository unregisters the snapshot repository, the snapshots stored in it are kept.
+func (api *OpensearchWrapper) DeleteRepository(name string) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SnapshotRepositoryDeleteReq{Repos: []string{name}}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// GetSnapshots returns the snapshots of the repository matching the pattern sorted by the start time,
+// all snapshots if the pattern is empty. The missing snapshot without the wildcard is an error.
+func (api *OpensearchWrapper) GetSnapshots(repository, pattern string) (snapshots.Snapshots, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result struct {
+		Snapshots snapshots.Snapshots `json:"snapshots"`
+	}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SnapshotGetReq{
+		Repo:      repository,
+		Snapshots: []string{fp.Ternary("*", pattern, pattern == "")},
+	}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	result.Snapshots.Sort()
+	return result.Snapshots, nil
+}
+
+// CreateSnapshot starts the snapshot in the background, use SnapshotStatus to follow it.
+func (api *OpensearchWrapper) CreateSnapshot(repository, name string, opts snapshots.CreateOptions) error {
+	body, err := opts.Body()
+	if err != nil {
+		return err
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SnapshotCreateReq{
+		Repo:     repository,
+		Snapshot: name,
+		Body:     bytes.NewReader(body),
+		Params:   opensearchapi.SnapshotCreateParams{WaitForCompletion: fp.AsPointer(false)},
+	}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// SnapshotStatus returns the detailed status of the snapshots, including the shard progress of the running ones.
+func (api *OpensearchWrapper) SnapshotStatus(repository string, names []string) (snapshots.Statuses, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result struct {
+		Snapshots snapshots.Statuses `json:"snapshots"`
+	}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SnapshotStatusReq{Repo: repository, Snapshots: names}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result.Snapshots, nil
+}
+
+// WaitForSnapshot polls the status of the snapshot with the interval until it is completed, passing every status
+// to the progress handler, and returns the completed snapshot.
+func (api *OpensearchWrapper) WaitForSnapshot(repository, name string, interval time.Duration, progress func(snapshots.Status)) (snapshots.Snapshot, error) {
+	for {
+		statuses, err := api.SnapshotStatus(repository, []string{name})
+		if err != nil {
+			return snapshots.Snapshot{}, err
+		} else if len(statuses) != 1 {
+			return snapshots.Snapshot{}, fmt.Errorf("snapshot '%s' is not found in repository '%s'", name, repository)
+		}
+		progress(statuses[0])
+		if snapshots.IsCompleted(statuses[0].State) {
+			break
+		}
+		time.Sleep(interval)
+	}
+	list, err := api.GetSnapshots(repository, name)
+	if err != nil {
+		return snapshots.Snapshot{}, err
+	} else if len(list) != 1 {
+		return snapshots.Snapshot{}, fmt.Errorf("snapshot '%s' is not found in repository '%s'", name, repository)
+	}
+	return list[0], nil
+}
+
+// DeleteSnapshots deletes the snapshots of the repository.
+func (api *OpensearchWrapper) DeleteSnapshots(repository string, names []string) error {
+	if len(names) == 0 {
+		return errors.New("no snapshots to delete")
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SnapshotDeleteReq{Repo: repository, Snapshots: names}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// RestoreSnapshot starts the restore of the snapshot in the background, use GetRecovery to follow it.
+func (api *OpensearchWrapper) RestoreSnapshot(repository, name string, opts snapshots.RestoreOptions) error {
+	body, err := opts.Body()
+	if err != nil {
+		return err
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.SnapshotRestoreReq{
+		Repo:     repository,
+		Snapshot: name,
+		Body:     bytes.NewReader(body),
+		Params:   opensearchapi.SnapshotRestoreParams{WaitForCompletion: fp.AsPointer(false)},
+	}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// GetRecovery returns the shard recoveries of the indices, it is empty until all the indices are created.
+func (api *OpensearchWrapper) GetRecovery(indices []string) (snapshots.Recovery, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	result := snapshots.Recovery{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.IndicesRecoveryReq{Indices: indices}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() && rsp.StatusCode != http.StatusNotFound {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/snapshots"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOpensearchWrapper_Snapshots(t *testing.T) {
+	wrapper := testWrapper()
+	repository, index, restored := "tc-snapshots", "tc-snapshot-source", "tc-restored-snapshot-source"
+	body, err := snapshots.RepositoryBody(snapshots.RepositoryFS, []string{"location=" + snapshotsPath + "/tc"})
+	assert.NoError(t, err)
+	assert.NoError(t, wrapper.CreateRepository(repository, body, true))
+	assert.NoError(t, wrapper.CreateIndex(index))
+	t.Cleanup(func() {
+		_ = wrapper.DeleteIndex(index)
+		_ = wrapper.DeleteIndex(restored)
+		_ = wrapper.DeleteSnapshots(repository, []string{"tc-snap-a", "tc-snap-b"})
+		_ = wrapper.DeleteRepository(repository)
+	})
+
+	repositories, err := wrapper.GetRepositories("tc-snap*")
+	assert.NoError(t, err)
+	assert.Equal(t, snapshots.RepositoryFS, repositories[repository].Type)
+	verification, err := wrapper.VerifyRepository(repository)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, verification.Nodes)
+
+	for _, name := range []string{"tc-snap-a", "tc-snap-b"} {
+		assert.NoError(t, wrapper.CreateSnapshot(repository, name, snapshots.CreateOptions{Indices: []string{index}}))
+		assert.Eventually(t, func() bool {
+			statuses, err := wrapper.SnapshotStatus(repository, []string{name})
+			return err == nil && len(statuses) == 1 && snapshots.IsCompleted(statuses[0].State)
+		}, 30*time.Second, 500*time.Millisecond)
+	}
+	list, err := wrapper.GetSnapshots(repository, "tc-snap-*")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tc-snap-a", "tc-snap-b"}, list.Names())
+	assert.Equal(t, snapshots.StateSuccess, list[0].State)
+	assert.Equal(t, []string{index}, list[0].Indices)
+
+	opts := snapshots.RestoreOptions{Indices: []string{"tc-snapshot-*"}, RenamePattern: "^tc-(.+)$", RenameReplacement: "tc-restored-$1"}
+	plan, err := opts.Plan(list[0].Indices, gu.GetMatchFunc)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{index: restored}, plan)
+	assert.NoError(t, wrapper.RestoreSnapshot(repository, "tc-snap-a", opts))
+	assert.Eventually(t, func() bool {
+		recovery, err := wrapper.GetRecovery([]string{restored})
+		done, _, _ := recovery.RestoreProgress()
+		return err == nil && done == 1
+	}, 30*time.Second, 500*time.Millisecond)
+
+	assert.NoError(t, wrapper.DeleteSnapshots(repository, []string{"tc-snap-a", "tc-snap-b"}))
+	list, err = wrapper.GetSnapshots(repository, "")
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -8,10 +8,11 @@ import (
 	"strings"
 )
 
-// GetStatsLag retrieves and displays replication lag statistics for a specified index.
+// GetStatsLag retrieves replication lag statistics for a specified index.
+// Returns an error if the replication of the index is in the FAILED state.
 // function wraps the following opensearch-go API call:
 // https://docs.opensearch.org/2.19/tuning-your-cluster/replication-plugin/api/#get-replication-status
-func (api *OpensearchWrapper) GetStatsLag(indexName string, raw bool) (tstats.IndexReplicationStatsResponse, error) {
+func (api *OpensearchWrapper) GetStatsLag(indexName string) (tstats.IndexReplicationStatsResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 	var result tstats.IndexReplicationStatsResponse
@@ -22,36 +23,16 @@ func (api *OpensearchWrapper) GetStatsLag(indexName string, raw bool) (tstats.In
 	if rsp.IsError() {
 		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	if raw {
-		log.Info().Msg(printutils.RawResponse(rsp))
-		return result, nil
-	} else {
-		log.Info().Msgf("replication status for index '%s':\n", indexName)
-		switch strings.ToUpper(result.Status) {
-		case "SYNCING":
-			log.Info().Msg("replication is in sync")
-			log.Info().Msgf("lag value (follower_checkpoint - leader_checkpoint): %d", result.SyncingDetails.FollowerCheckpoint-result.SyncingDetails.LeaderCheckpoint)
-		case "BOOTSTRAPPING":
-			log.Info().Msg("replication is in bootstrap mode")
-			log.Info().Msgf("reason:%s", result.Reason)
-			log.Info().Msgf("lag value (follower_checkpoint - leader_checkpoint): %d", result.SyncingDetails.FollowerCheckpoint-result.SyncingDetails.LeaderCheckpoint)
-		case "PAUSED":
-			log.Info().Msg("replication is paused")
-			log.Info().Msgf("reason:%s", result.Reason)
-		case "REPLICATION NOT IN PROGRESS":
-			log.Info().Msg("replication is not in progress")
-			log.Info().Msgf("reason:%s", result.Reason)
-		case "FAILED":
-			return result, fmt.Errorf("replication failed for index '%s'\nreason:\n%s", indexName, result.Reason)
-		}
+	if strings.ToUpper(result.Status) == "FAILED" {
+		return result, fmt.Errorf("replication failed for index '%s'\nreason:\n%s", indexName, result.Reason)
 	}
 	return result, nil
 }
 
-// GetReplicationLeaderStats retrieves and displays replication leader statistics for all indices.
+// GetReplicationLeaderStats retrieves replication leader statistics for all indices.
 // function wraps the following opensearch-go API call:
 // https://docs.opensearch.org/2.19/tuning-your-cluster/replication-plugin/api/#get-leader-cluster-stats
-func (api *OpensearchWrapper) GetReplicationLeaderStats(raw bool) (tstats.ReplicationLeaderStatsResponse, error) {
+func (api *OpensearchWrapper) GetReplicationLeaderStats() (tstats.ReplicationLeaderStatsResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 
@@ -63,19 +44,13 @@ func (api *OpensearchWrapper) GetReplicationLeaderStats(raw bool) (tstats.Replic
 	if rsp.IsError() {
 		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	if raw {
-		log.Info().Msg(printutils.RawResponse(rsp))
-		return result, nil
-	} else {
-		log.Info().Msgf("\n%s\n", printutils.MarshalJSONOrDie(result))
-	}
 	return result, nil
 }
 
-// GetReplicationFollowerStats retrieves and displays replication follower statistics for all indices.
+// GetReplicationFollowerStats retrieves replication follower statistics for all indices.
 // function wraps the following opensearch-go API call:
 // https://docs.opensearch.org/2.19/tuning-your-cluster/replication-plugin/api/#get-follower-cluster-stats
-func (api *OpensearchWrapper) GetReplicationFollowerStats(raw bool) (tstats.ReplicationFollowerStatsResponse, error) {
+func (api *OpensearchWrapper) GetReplicationFollowerStats() (tstats.ReplicationFollowerStatsResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 
@@ -87,19 +62,13 @@ func (api *OpensearchWrapper) GetReplicationFollowerStats(raw bool) (tstats.Repl
 	if rsp.IsError() {
 		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	if raw {
-		log.Info().Msg(printutils.RawResponse(rsp))
-		return result, nil
-	} else {
-		log.Info().Msgf("\n%s\n", printutils.MarshalJSONOrDie(result))
-	}
 	return result, nil
 }
 
-// GetReplicationAutofollowStats retrieves and displays replication autofollow statistics for all indices.
+// GetReplicationAutofollowStats retrieves replication autofollow statistics for all indices.
 // function wraps the following opensearch-go API call:
 // https://docs.opensearch.org/2.19/tuning-your-cluster/replication-plugin/api/#get-auto-follow-stats
-func (api *OpensearchWrapper) GetReplicationAutofollowStats(raw bool) (tstats.ReplicationAutoFollowStatsResponse, error) {
+func (api *OpensearchWrapper) GetReplicationAutofollowStats() (tstats.ReplicationAutoFollowStatsResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 
@@ -111,17 +80,11 @@ func (api *OpensearchWrapper) GetReplicationAutofollowStats(raw bool) (tstats.Re
 	if rsp.IsError() {
 		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	if raw {
-		log.Info().Msg(printutils.RawResponse(rsp))
-		return result, nil
-	} else {
-		log.Info().Msgf("\n%s\n", printutils.MarshalJSONOrDie(result))
-	}
 	return result, nil
 }
 
-// ListOfAFRules - shows the list of configured autofollow rules
-func (api *OpensearchWrapper) ListOfAFRules(raw bool) (tstats.ReplicationAutoFollowStatsResponse, error) {
+// ListOfAFRules - returns the list of configured autofollow rules
+func (api *OpensearchWrapper) ListOfAFRules() (tstats.ReplicationAutoFollowStatsResponse, error) {
 	ctx, cancelFunc := api.requestContext()
 	defer cancelFunc()
 
@@ -133,14 +96,5 @@ func (api *OpensearchWrapper) ListOfAFRules(raw bool) (tstats.ReplicationAutoFol
 	if rsp.IsError() {
 		return result, errors.New(printutils.RawResponse(rsp))
 	}
-	if raw {
-		log.Info().Msg(printutils.RawResponse(rsp))
-		return result, nil
-	} else {
-		log.Info().Msg("configured autofollow rules:")
-		for _, afr := range result.AutofollowStats {
-			log.Info().Msgf("name: '%s' | pattern: '%s' | failed indices: %v", afr.Name, afr.Pattern, afr.FailedIndices)
-		}
-	}
 	return result, nil
 }
@@ -31,7 +31,7 @@ func TestOpensearchWrapper_GetReplicationAutofollowStats(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getNamedCCR(ccrName), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getNamedCCR(ccrName))), "expected to configure remote cluster")
 				t.Log("[follower]creating af rules")
 				afRule := replication.CreateAutofollowReq{
 					Header: nil,
@@ -41,24 +41,24 @@ func TestOpensearchWrapper_GetReplicationAutofollowStats(t *testing.T) {
 						IndexPattern: replicatedIndex,
 					},
 				}
-				assert.NoError(t, c.CreateAutofollowRule(afRule, true))
+				assert.NoError(t, noResult(c.CreateAutofollowRule(afRule)))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower]configured]")
 			},
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("[follower]cleaning up")
 				assert.NotNil(t, c)
-				assert.NoError(t, c.DeleteAutofollow(replication.DeleteAutofollowReq{
+				assert.NoError(t, noResult(c.DeleteAutofollow(replication.DeleteAutofollowReq{
 					Header: nil,
 					Body: replication.DeleteAutofollowBody{
 						Name:        replicatedIndex,
 						LeaderAlias: ccrName,
 					},
-				}, true))
+				})))
 				t.Log("[follower]cleaning up remote cluster")
-				assert.NoError(t, c.DeleteRemote(ccrName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(ccrName)))
 				t.Log("[follower]stop replication")
-				if err := c.StopReplication(replicatedIndex, true); err != nil {
+				if _, err := c.StopReplication(replicatedIndex); err != nil {
 					t.Log(err)
 				}
 				t.Log("[follower]cleaning up indices")
@@ -114,7 +114,7 @@ func TestOpensearchWrapper_GetReplicationAutofollowStats(t *testing.T) {
 			}
 			t.Log("configured")
 			// actual test
-			autofollowStats, executionError := tt.Wrapper.GetReplicationAutofollowStats(true)
+			autofollowStats, executionError := tt.Wrapper.GetReplicationAutofollowStats()
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
@@ -147,7 +147,7 @@ func TestOpensearchWrapper_GetReplicationFollowerStats(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getNamedCCR(ccrName), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getNamedCCR(ccrName))), "expected to configure remote cluster")
 				t.Log("[follower]creating af rules")
 				afRule := replication.CreateAutofollowReq{
 					Header: nil,
@@ -157,24 +157,24 @@ func TestOpensearchWrapper_GetReplicationFollowerStats(t *testing.T) {
 						IndexPattern: replicatedIndex,
 					},
 				}
-				assert.NoError(t, c.CreateAutofollowRule(afRule, true))
+				assert.NoError(t, noResult(c.CreateAutofollowRule(afRule)))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower]configured]")
 			},
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("[follower]cleaning up")
 				assert.NotNil(t, c)
-				assert.NoError(t, c.DeleteAutofollow(replication.DeleteAutofollowReq{
+				assert.NoError(t, noResult(c.DeleteAutofollow(replication.DeleteAutofollowReq{
 					Header: nil,
 					Body: replication.DeleteAutofollowBody{
 						Name:        replicatedIndex,
 						LeaderAlias: ccrName,
 					},
-				}, true))
+				})))
 				t.Log("[follower]cleaning up remote cluster")
-				assert.NoError(t, c.DeleteRemote(ccrName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(ccrName)))
 				t.Log("[follower]stop replication")
-				if err := c.StopReplication(replicatedIndex, true); err != nil {
+				if _, err := c.StopReplication(replicatedIndex); err != nil {
 					t.Log(err)
 				}
 				t.Log("[follower]cleaning up indices")
@@ -214,7 +214,7 @@ func TestOpensearchWrapper_GetReplicationFollowerStats(t *testing.T) {
 			t.Log("configured")
 			time.Sleep(2 * time.Second)
 			// actual test
-			followerStats, executionError := tt.Wrapper.GetReplicationFollowerStats(true)
+			followerStats, executionError := tt.Wrapper.GetReplicationFollowerStats()
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
@@ -247,7 +247,7 @@ func TestOpensearchWrapper_GetReplicationLeaderStats(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getNamedCCR(ccrName), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getNamedCCR(ccrName))), "expected to configure remote cluster")
 				t.Log("[follower]creating af rules")
 				afRule := replication.CreateAutofollowReq{
 					Header: nil,
@@ -257,24 +257,24 @@ func TestOpensearchWrapper_GetReplicationLeaderStats(t *testing.T) {
 						IndexPattern: replicatedIndex,
 					},
 				}
-				assert.NoError(t, c.CreateAutofollowRule(afRule, true))
+				assert.NoError(t, noResult(c.CreateAutofollowRule(afRule)))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower]configured]")
 			},
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("[follower]cleaning up")
 				assert.NotNil(t, c)
-				assert.NoError(t, c.DeleteAutofollow(replication.DeleteAutofollowReq{
+				assert.NoError(t, noResult(c.DeleteAutofollow(replication.DeleteAutofollowReq{
 					Header: nil,
 					Body: replication.DeleteAutofollowBody{
 						Name:        replicatedIndex,
 						LeaderAlias: ccrName,
 					},
-				}, true))
+				})))
 				t.Log("[follower]cleaning up remote cluster")
-				assert.NoError(t, c.DeleteRemote(ccrName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(ccrName)))
 				t.Log("[follower]stop replication")
-				if err := c.StopReplication(replicatedIndex, true); err != nil {
+				if _, err := c.StopReplication(replicatedIndex); err != nil {
 					t.Log(err)
 				}
 				t.Log("[follower]cleaning up indices")
@@ -314,7 +314,7 @@ func TestOpensearchWrapper_GetReplicationLeaderStats(t *testing.T) {
 			t.Log("configured")
 			time.Sleep(2 * time.Second)
 			// actual test
-			leaderStats, executionError := tt.Wrapper.GetReplicationLeaderStats(true)
+			leaderStats, executionError := tt.Wrapper.GetReplicationLeaderStats()
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
@@ -348,7 +348,7 @@ func TestOpensearchWrapper_GetStatsLag(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getNamedCCR(ccrName), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getNamedCCR(ccrName))), "expected to configure remote cluster")
 				t.Log("[follower]creating af rules")
 				afRule := replication.CreateAutofollowReq{
 					Header: nil,
@@ -358,24 +358,24 @@ func TestOpensearchWrapper_GetStatsLag(t *testing.T) {
 						IndexPattern: replicatedIndex,
 					},
 				}
-				assert.NoError(t, c.CreateAutofollowRule(afRule, true))
+				assert.NoError(t, noResult(c.CreateAutofollowRule(afRule)))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower]configured]")
 			},
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("[follower]cleaning up")
 				assert.NotNil(t, c)
-				assert.NoError(t, c.DeleteAutofollow(replication.DeleteAutofollowReq{
+				assert.NoError(t, noResult(c.DeleteAutofollow(replication.DeleteAutofollowReq{
 					Header: nil,
 					Body: replication.DeleteAutofollowBody{
 						Name:        replicatedIndex,
 						LeaderAlias: ccrName,
 					},
-				}, true))
+				})))
 				t.Log("[follower]cleaning up remote cluster")
-				assert.NoError(t, c.DeleteRemote(ccrName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(ccrName)))
 				t.Log("[follower]stop replication")
-				if err := c.StopReplication(replicatedIndex, true); err != nil {
+				if _, err := c.StopReplication(replicatedIndex); err != nil {
 					t.Log(err)
 				}
 				t.Log("[follower]cleaning up indices")
@@ -415,7 +415,7 @@ func TestOpensearchWrapper_GetStatsLag(t *testing.T) {
 			t.Log("configured")
 			time.Sleep(2 * time.Second)
 			// actual test
-			statsLag, executionError := tt.Wrapper.GetStatsLag(tt.CaseInput.(string), false)
+			statsLag, executionError := tt.Wrapper.GetStatsLag(tt.CaseInput.(string))
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
@@ -465,16 +465,16 @@ func TestOpensearchWrapper_ListOfAFRules(t *testing.T) {
 			ConfigureFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				assert.NotNil(t, c)
 				t.Log("[follower]configuring remote cluster")
-				assert.NoError(t, c.ConfigureRemoteCluster(getNamedCCR(ccrName), true), "expected to configure remote cluster")
+				assert.NoError(t, noResult(c.ConfigureRemoteCluster(getNamedCCR(ccrName))), "expected to configure remote cluster")
 				t.Log("[follower]creating af rules")
-				assert.NoError(t, c.CreateAutofollowRule(replication.CreateAutofollowReq{
+				assert.NoError(t, noResult(c.CreateAutofollowRule(replication.CreateAutofollowReq{
 					Header: nil,
 					Body: replication.CreateAutofollowBody{
 						Name:         afRuleName,
 						LeaderAlias:  ccrName,
 						IndexPattern: afIndexPattern,
 					},
-				}, true))
+				})))
 				time.Sleep(1 * time.Second)
 				t.Log("[follower]configured]")
 			},
@@ -489,15 +489,15 @@ func TestOpensearchWrapper_ListOfAFRules(t *testing.T) {
 			PostFollowerFunc: func(t *testing.T, c *OpensearchWrapper) {
 				t.Log("[follower]cleaning up")
 				assert.NotNil(t, c)
-				assert.NoError(t, c.DeleteAutofollow(replication.DeleteAutofollowReq{
+				assert.NoError(t, noResult(c.DeleteAutofollow(replication.DeleteAutofollowReq{
 					Header: nil,
 					Body: replication.DeleteAutofollowBody{
 						Name:        afRuleName,
 						LeaderAlias: ccrName,
 					},
-				}, true))
+				})))
 				t.Log("[follower]cleaning up remote cluster")
-				assert.NoError(t, c.DeleteRemote(ccrName, true))
+				assert.NoError(t, noResult(c.DeleteRemote(ccrName)))
 				t.Log("[follower]cleaning up indices")
 				registeredIndices, err := c.GetIndexList()
 				assert.NoError(t, err)
@@ -505,7 +505,7 @@ func TestOpensearchWrapper_ListOfAFRules(t *testing.T) {
 					return info.Index
 				})
 				for _, index := range fp.Filter(indexNames, gu.GetMatchFunc(afIndexPattern)) {
-					assert.NoError(t, c.StopReplication(index, true))
+					assert.NoError(t, noResult(c.StopReplication(index)))
 					time.Sleep(100 * time.Millisecond)
 					assert.NoError(t, c.DeleteIndex(index))
 				}
@@ -550,7 +550,7 @@ func TestOpensearchWrapper_ListOfAFRules(t *testing.T) {
 			}
 			t.Log("configured")
 			// actual test
-			afRules, executionError := tt.Wrapper.ListOfAFRules(true)
+			afRules, executionError := tt.Wrapper.ListOfAFRules()
 			if tt.WantErr {
 				assert.Error(t, executionError, "expected to get error")
 			} else {
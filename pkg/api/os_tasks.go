@@ -0,0 +1,95 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/tasks"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"net/http"
+	"time"
+)
+
+// ErrTaskNotFound is returned by GetTask if the task isn't running and its result isn't stored,
+// e.g. the completed force merge or replication bootstrap tasks.
+var ErrTaskNotFound = errors.New("task not found")
+
+// ListTasks returns the running tasks selected by the options sorted by the start time.
+func (api *OpensearchWrapper) ListTasks(opts tasks.ListOptions) (tasks.Tasks, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result struct {
+		Tasks tasks.Tasks `json:"tasks"`
+	}
+	rsp, err := api.Client.Do(ctx, opensearchapi.TasksListReq{
+		Params: opensearchapi.TasksListParams{
+			Actions:      opts.Actions,
+			Nodes:        opts.Nodes,
+			ParentTaskID: opts.ParentTaskID,
+			Detailed:     fp.AsPointer(true),
+			GroupBy:      "none",
+		},
+	}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	result.Tasks.Sort()
+	return result.Tasks, nil
+}
+
+// GetTask returns the state of the task with the 'node:id' identifier, ErrTaskNotFound if the task isn't running
+// and its result isn't stored.
+func (api *OpensearchWrapper) GetTask(taskID string) (tasks.TaskResult, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result tasks.TaskResult
+	rsp, err := api.Client.Do(ctx, opensearchapi.TasksGetReq{TaskID: taskID}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.StatusCode == http.StatusNotFound {
+		return result, fmt.Errorf("%w:%s", ErrTaskNotFound, printutils.RawResponse(rsp))
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
+
+// WaitForTask polls the task with the interval until it is completed, passing every state to the progress handler,
+// and returns the completed task. The task which isn't found after it was seen running is completed without
+// the stored result, e.g. the force merge, its last polled state is returned as completed.
+func (api *OpensearchWrapper) WaitForTask(taskID string, interval time.Duration, progress func(tasks.TaskResult)) (tasks.TaskResult, error) {
+	var last *tasks.TaskResult
+	for {
+		result, err := api.GetTask(taskID)
+		if errors.Is(err, ErrTaskNotFound) && last != nil {
+			last.Completed = true
+			progress(*last)
+			return *last, nil
+		} else if err != nil {
+			return result, err
+		}
+		progress(result)
+		if result.Completed {
+			return result, nil
+		}
+		last = &result
+		time.Sleep(interval)
+	}
+}
+
+// CancelTask requests the cancellation of the task, the task is stopped asynchronously.
+func (api *OpensearchWrapper) CancelTask(taskID string) error {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, opensearchapi.TasksCancelReq{TaskID: taskID}, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
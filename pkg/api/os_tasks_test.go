@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/reindex"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/tasks"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// startThrottledReindex starts the reindex of the source index throttled to keep its task running for a while,
+// returns the task identifier.
+func startThrottledReindex(t *testing.T, c *OpensearchWrapper, source, dest string) string {
+	assert.NoError(t, c.CreateIndex(source))
+	t.Cleanup(func() {
+		_ = c.DeleteIndex(source)
+		_ = c.DeleteIndex(dest)
+	})
+	var docs bytes.Buffer
+	for n := 0; n < 20; n++ {
+		docs.WriteString(fmt.Sprintf("{\"index\":{\"_id\":\"%d\"}}\n{\"n\":%d}\n", n, n))
+	}
+	_, err := c.Client.Do(t.Context(), opensearchapi.BulkReq{
+		Index:  source,
+		Body:   &docs,
+		Params: opensearchapi.BulkParams{Refresh: "true"},
+	}, nil)
+	assert.NoError(t, err)
+	taskID, err := c.Reindex(reindex.Options{
+		Source:            []string{source},
+		Dest:              dest,
+		RequestsPerSecond: fp.AsPointer(1),
+	})
+	assert.NoError(t, err)
+	return taskID
+}
+
+func TestOpensearchWrapper_ListTasks(t *testing.T) {
+	tests := []OSSingleContainerTest{
+		{
+			Name:      "list tasks of the action pattern",
+			Wrapper:   testWrapper(),
+			CaseInput: tasks.ListOptions{Actions: []string{"cluster:monitor/tasks/lists*"}},
+		},
+		{
+			Name:      "list tasks of the action that isn't running",
+			Wrapper:   testWrapper(),
+			CaseInput: tasks.ListOptions{Actions: []string{"tc:missing/*"}},
+		},
+		{
+			Name:      "list tasks of the parent that isn't running",
+			Wrapper:   testWrapper(),
+			CaseInput: tasks.ListOptions{Actions: []string{"cluster:monitor/tasks/lists*"}, ParentTaskID: "missing:1"},
+		},
+		{
+			Name:      "list tasks with the invalid parent",
+			Wrapper:   testWrapper(),
+			CaseInput: tasks.ListOptions{ParentTaskID: "missing"},
+			WantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			opts := tt.CaseInput.(tasks.ListOptions)
+			list, executionErr := tt.Wrapper.ListTasks(opts)
+			if tt.WantErr {
+				assert.Error(t, executionErr, "expected to get error")
+				return
+			}
+			assert.NoError(t, executionErr, "expected to get no error")
+			if opts.ParentTaskID == "" && opts.Actions[0] == "cluster:monitor/tasks/lists*" {
+				assert.Contains(t, fp.Map(list, func(task tasks.Task) string { return task.Action }), "cluster:monitor/tasks/lists",
+					"the list request is running while the tasks are listed")
+			} else {
+				assert.Empty(t, list)
+			}
+		})
+	}
+}
+
+func TestOpensearchWrapper_GetAndCancelTask(t *testing.T) {
+	wrapper := testWrapper()
+	taskID := startThrottledReindex(t, wrapper, "tc-tasks-source", "tc-tasks-dest")
+
+	result, err := wrapper.GetTask(taskID)
+	assert.NoError(t, err)
+	assert.False(t, result.Completed, "throttled reindex is expected to be running")
+	assert.Equal(t, taskID, result.Task.TaskID())
+	assert.Equal(t, "indices:data/write/reindex", result.Task.Action)
+	assert.True(t, result.Task.Cancellable)
+
+	list, err := wrapper.ListTasks(tasks.ListOptions{Actions: []string{"indices:data/write/reindex"}})
+	assert.NoError(t, err)
+	assert.Contains(t, fp.Map(list, tasks.Task.TaskID), taskID)
+
+	assert.NoError(t, wrapper.CancelTask(taskID))
+	assert.Eventually(t, func() bool {
+		result, err = wrapper.GetTask(taskID)
+		return err == nil && result.Completed
+	}, time.Minute, time.Second)
+	var response map[string]any
+	assert.NoError(t, json.Unmarshal(result.Response, &response))
+	assert.Contains(t, response, "canceled", "reindex response is expected to report the cancellation")
+
+	_, err = wrapper.GetTask("missing:1")
+	assert.Error(t, err)
+	assert.Error(t, wrapper.CancelTask("missing:1"))
+	_, err = wrapper.GetTask(fmt.Sprintf("%s:%d", result.Task.Node, result.Task.ID+1000000))
+	assert.True(t, errors.Is(err, ErrTaskNotFound), "unknown task of the existing node isn't found:%v", err)
+}
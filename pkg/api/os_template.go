@@ -0,0 +1,141 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/templates"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	printutils "github.com/dalet-oss/opensearch-cli/pkg/utils/print"
+	"github.com/opensearch-project/opensearch-go/v4"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"net/http"
+)
+
+// GetIndexTemplates returns the index templates with the name compliant with the pattern, all templates if it is empty.
+func (api *OpensearchWrapper) GetIndexTemplates(pattern string) (templates.IndexTemplates, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	req := opensearchapi.IndexTemplateGetReq{}
+	if pattern != "" {
+		req.IndexTemplates = []string{pattern}
+	}
+	var result struct {
+		IndexTemplates templates.IndexTemplates `json:"index_templates"`
+	}
+	rsp, err := api.Client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.StatusCode == http.StatusNotFound {
+		return templates.IndexTemplates{}, nil
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result.IndexTemplates, nil
+}
+
+// GetComponentTemplates returns the component templates with the name compliant with the pattern, all templates if it is empty.
+func (api *OpensearchWrapper) GetComponentTemplates(pattern string) (templates.ComponentTemplates, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result struct {
+		ComponentTemplates templates.ComponentTemplates `json:"component_templates"`
+	}
+	rsp, err := api.Client.Do(ctx, opensearchapi.ComponentTemplateGetReq{ComponentTemplate: pattern}, &result)
+	if err != nil {
+		return nil, err
+	} else if rsp.StatusCode == http.StatusNotFound {
+		return templates.ComponentTemplates{}, nil
+	} else if rsp.IsError() {
+		return nil, errors.New(printutils.RawResponse(rsp))
+	}
+	return result.ComponentTemplates, nil
+}
+
+// GetTemplate returns the definition of the template, it is nil if the template doesn't exist.
+func (api *OpensearchWrapper) GetTemplate(kind templates.Kind, name string) (json.RawMessage, error) {
+	switch kind {
+	case templates.KindIndex:
+		list, err := api.GetIndexTemplates(name)
+		if err != nil || len(list) != 1 || list[0].Name != name {
+			return nil, err
+		}
+		return list[0].IndexTemplate, nil
+	case templates.KindComponent:
+		list, err := api.GetComponentTemplates(name)
+		if err != nil || len(list) != 1 || list[0].Name != name {
+			return nil, err
+		}
+		return list[0].ComponentTemplate, nil
+	}
+	return nil, fmt.Errorf("unknown template kind '%s'", kind)
+}
+
+// PutTemplate creates or replaces the template, create fails the request if the template already exists.
+func (api *OpensearchWrapper) PutTemplate(kind templates.Kind, name string, body []byte, create bool) error {
+	var req opensearch.Request
+	switch kind {
+	case templates.KindIndex:
+		req = opensearchapi.IndexTemplateCreateReq{
+			IndexTemplate: name,
+			Body:          bytes.NewReader(body),
+			Params:        opensearchapi.IndexTemplateCreateParams{Create: fp.AsPointer(create)},
+		}
+	case templates.KindComponent:
+		req = opensearchapi.ComponentTemplateCreateReq{
+			ComponentTemplate: name,
+			Body:              bytes.NewReader(body),
+			Params:            opensearchapi.ComponentTemplateCreateParams{Create: fp.AsPointer(create)},
+		}
+	default:
+		return fmt.Errorf("unknown template kind '%s'", kind)
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, req, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// DeleteTemplate deletes the template.
+func (api *OpensearchWrapper) DeleteTemplate(kind templates.Kind, name string) error {
+	var req opensearch.Request
+	switch kind {
+	case templates.KindIndex:
+		req = opensearchapi.IndexTemplateDeleteReq{IndexTemplate: name}
+	case templates.KindComponent:
+		req = opensearchapi.ComponentTemplateDeleteReq{ComponentTemplate: name}
+	default:
+		return fmt.Errorf("unknown template kind '%s'", kind)
+	}
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result interface{}
+	rsp, err := api.Client.Do(ctx, req, &result)
+	if err != nil {
+		return err
+	} else if rsp.IsError() {
+		return errors.New(printutils.RawResponse(rsp))
+	}
+	return nil
+}
+
+// SimulateIndex returns the effective settings, mappings and aliases the new index with the name would get from the templates.
+func (api *OpensearchWrapper) SimulateIndex(indexName string) (templates.Simulation, error) {
+	ctx, cancelFunc := api.requestContext()
+	defer cancelFunc()
+	var result templates.Simulation
+	rsp, err := api.Client.Do(ctx, opensearchapi.IndexTemplateSimulateIndexReq{Index: indexName}, &result)
+	if err != nil {
+		return result, err
+	} else if rsp.IsError() {
+		return result, errors.New(printutils.RawResponse(rsp))
+	}
+	return result, nil
+}
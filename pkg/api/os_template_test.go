@@ -0,0 +1,47 @@
+package api
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/templates"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOpensearchWrapper_Templates(t *testing.T) {
+	wrapper := testWrapper()
+	component := []byte(`{"template":{"settings":{"number_of_replicas":0}}}`)
+	index := []byte(`{"index_patterns":["tc-template-*"],"priority":5,"composed_of":["tc-template-base"],` +
+		`"template":{"mappings":{"properties":{"level":{"type":"keyword"}}}}}`)
+	assert.NoError(t, wrapper.PutTemplate(templates.KindComponent, "tc-template-base", component, false))
+	assert.NoError(t, wrapper.PutTemplate(templates.KindIndex, "tc-template", index, true))
+	assert.Error(t, wrapper.PutTemplate(templates.KindIndex, "tc-template", index, true))
+	t.Cleanup(func() {
+		_ = wrapper.DeleteTemplate(templates.KindIndex, "tc-template")
+		_ = wrapper.DeleteTemplate(templates.KindComponent, "tc-template-base")
+	})
+
+	indexTemplates, err := wrapper.GetIndexTemplates("tc-template*")
+	assert.NoError(t, err)
+	assert.Len(t, indexTemplates, 1)
+	componentTemplates, err := wrapper.GetComponentTemplates("tc-template*")
+	assert.NoError(t, err)
+	assert.Len(t, componentTemplates, 1)
+	missing, err := wrapper.GetTemplate(templates.KindIndex, "tc-template-missing")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	definition, err := wrapper.GetTemplate(templates.KindIndex, "tc-template")
+	assert.NoError(t, err)
+	drifts, err := templates.Diff(index, definition)
+	assert.NoError(t, err)
+	assert.Empty(t, drifts)
+
+	simulation, err := wrapper.SimulateIndex("tc-template-a")
+	assert.NoError(t, err)
+	settings, err := templates.FlatSettings(simulation.Template.Settings)
+	assert.NoError(t, err)
+	assert.Equal(t, "0", settings["index.number_of_replicas"])
+	assert.Contains(t, string(simulation.Template.Mappings), "keyword")
+
+	assert.NoError(t, wrapper.DeleteTemplate(templates.KindIndex, "tc-template"))
+	assert.NoError(t, wrapper.DeleteTemplate(templates.KindComponent, "tc-template-base"))
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// discoveringTransport wraps the HTTP transport and refreshes the list of the cluster nodes when a node fails to answer.
+// The discovery runs at most once per client, so the short living cli process doesn't flood the cluster with node requests.
+type discoveringTransport struct {
+	next       http.RoundTripper
+	discover   func() error
+	discovered atomic.Bool
+}
+
+// RoundTrip executes the request, a transport error triggers the node discovery before the client retries the request on the next node.
+func (t *discoveringTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rsp, err := t.next.RoundTrip(req)
+	if err != nil && t.discover != nil && t.discovered.CompareAndSwap(false, true) {
+		log.Debug().Msgf("node '%s' failed to answer, discovering cluster nodes:%v", req.URL.Host, err)
+		if discoverErr := t.discover(); discoverErr != nil {
+			log.Debug().Msgf("unable to discover cluster nodes:%v", discoverErr)
+		}
+	}
+	return rsp, err
+}
@@ -0,0 +1,75 @@
+package aliases
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Options holds the properties of the alias set by the add action.
+type Options struct {
+	// Filter is the query limiting the documents visible through the alias.
+	Filter json.RawMessage
+	// Routing sets both IndexRouting and SearchRouting.
+	Routing       string
+	IndexRouting  string
+	SearchRouting string
+	// IsWriteIndex marks the index the writes to the alias go to, nil keeps the cluster default.
+	IsWriteIndex *bool
+}
+
+// IsEmpty reports whether none of the options is set.
+func (o Options) IsEmpty() bool {
+	return len(o.Filter) == 0 && o.Routing == "" && o.IndexRouting == "" && o.SearchRouting == "" && o.IsWriteIndex == nil
+}
+
+// ActionParams holds the parameters of the single add or remove action.
+type ActionParams struct {
+	Index         string          `json:"index"`
+	Alias         string          `json:"alias"`
+	Filter        json.RawMessage `json:"filter,omitempty"`
+	Routing       string          `json:"routing,omitempty"`
+	IndexRouting  string          `json:"index_routing,omitempty"`
+	SearchRouting string          `json:"search_routing,omitempty"`
+	IsWriteIndex  *bool           `json:"is_write_index,omitempty"`
+}
+
+// Action is the single action of the _aliases request, exactly one of the fields is set.
+type Action struct {
+	Add    *ActionParams `json:"add,omitempty"`
+	Remove *ActionParams `json:"remove,omitempty"`
+}
+
+// AddActions returns the actions adding the alias with the options to every index.
+func AddActions(alias string, indices []string, opts Options) []Action {
+	actions := make([]Action, 0, len(indices))
+	for _, index := range indices {
+		actions = append(actions, Action{Add: &ActionParams{
+			Index:         index,
+			Alias:         alias,
+			Filter:        opts.Filter,
+			Routing:       opts.Routing,
+			IndexRouting:  opts.IndexRouting,
+			SearchRouting: opts.SearchRouting,
+			IsWriteIndex:  opts.IsWriteIndex,
+		}})
+	}
+	return actions
+}
+
+// RemoveActions returns the actions removing the alias from every index.
+func RemoveActions(alias string, indices []string) []Action {
+	actions := make([]Action, 0, len(indices))
+	for _, index := range indices {
+		actions = append(actions, Action{Remove: &ActionParams{Index: index, Alias: alias}})
+	}
+	return actions
+}
+
+// SwapActions returns the actions moving the alias from the indices to the new ones, they are applied atomically
+// by the single _aliases request. The indices present in both lists are not removed, their alias is updated with the options.
+func SwapActions(alias string, from, to []string, opts Options) []Action {
+	removed := slices.DeleteFunc(slices.Clone(from), func(index string) bool {
+		return slices.Contains(to, index)
+	})
+	return append(RemoveActions(alias, removed), AddActions(alias, to, opts)...)
+}
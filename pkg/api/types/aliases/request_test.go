@@ -0,0 +1,52 @@
+package aliases
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSwapActions(t *testing.T) {
+	writeIndex := true
+	tests := []struct {
+		name     string
+		from     []string
+		to       []string
+		opts     Options
+		expected string
+	}{
+		{
+			name:     "move to the new index",
+			from:     []string{"logs-v1"},
+			to:       []string{"logs-v2"},
+			expected: `[{"remove":{"index":"logs-v1","alias":"logs"}},{"add":{"index":"logs-v2","alias":"logs"}}]`,
+		},
+		{
+			name: "kept index is updated with the options",
+			from: []string{"logs-v1", "logs-v2"},
+			to:   []string{"logs-v2"},
+			opts: Options{Filter: json.RawMessage(`{"term":{"level":"ERROR"}}`), Routing: "1", IsWriteIndex: &writeIndex},
+			expected: `[{"remove":{"index":"logs-v1","alias":"logs"}},` +
+				`{"add":{"index":"logs-v2","alias":"logs","filter":{"term":{"level":"ERROR"}},"routing":"1","is_write_index":true}}]`,
+		},
+		{
+			name:     "new alias",
+			to:       []string{"a", "b"},
+			expected: `[{"add":{"index":"a","alias":"logs"}},{"add":{"index":"b","alias":"logs"}}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions, err := json.Marshal(SwapActions("logs", tt.from, tt.to, tt.opts))
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(actions))
+		})
+	}
+}
+
+func TestOptions_IsEmpty(t *testing.T) {
+	writeIndex := false
+	assert.True(t, Options{}.IsEmpty())
+	assert.False(t, Options{SearchRouting: "1,2"}.IsEmpty())
+	assert.False(t, Options{IsWriteIndex: &writeIndex}.IsEmpty())
+}
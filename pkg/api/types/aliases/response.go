@@ -0,0 +1,101 @@
+package aliases
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Alias describes the alias of the single index.
+type Alias struct {
+	Alias         string          `json:"alias"`
+	Index         string          `json:"index"`
+	Filter        json.RawMessage `json:"filter,omitempty"`
+	IndexRouting  string          `json:"index_routing,omitempty"`
+	SearchRouting string          `json:"search_routing,omitempty"`
+	IsWriteIndex  *bool           `json:"is_write_index,omitempty"`
+}
+
+// Aliases is the list of aliases, it is rendered as a table.
+type Aliases []Alias
+
+// TableHeader returns the column names of the aliases table.
+func (a Aliases) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"alias", "index", "is_write_index", "routing.index", "routing.search", "filter"}
+	}
+	return []string{"alias", "index", "is_write_index", "filter"}
+}
+
+// TableRows returns a row per alias of the index, the narrow table shows '*' if the alias is filtered.
+func (a Aliases) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(a))
+	for _, alias := range a {
+		writeIndex := "-"
+		if alias.IsWriteIndex != nil {
+			writeIndex = strconv.FormatBool(*alias.IsWriteIndex)
+		}
+		filter := "-"
+		if len(alias.Filter) > 0 {
+			filter = "*"
+			if wide {
+				compact := bytes.Buffer{}
+				if json.Compact(&compact, alias.Filter) == nil {
+					filter = compact.String()
+				}
+			}
+		}
+		if wide {
+			rows = append(rows, []string{alias.Alias, alias.Index, writeIndex, alias.IndexRouting, alias.SearchRouting, filter})
+		} else {
+			rows = append(rows, []string{alias.Alias, alias.Index, writeIndex, filter})
+		}
+	}
+	return rows
+}
+
+// Indices returns the sorted indices the alias points to.
+func (a Aliases) Indices(alias string) []string {
+	var indices []string
+	for _, e := range a {
+		if e.Alias == alias {
+			indices = append(indices, e.Index)
+		}
+	}
+	slices.Sort(indices)
+	return indices
+}
+
+// ByIndex returns the sorted alias names keyed by the index name.
+func (a Aliases) ByIndex() map[string][]string {
+	byIndex := map[string][]string{}
+	for _, e := range a {
+		byIndex[e.Index] = append(byIndex[e.Index], e.Alias)
+	}
+	for _, names := range byIndex {
+		slices.Sort(names)
+	}
+	return byIndex
+}
+
+// Names returns the sorted unique alias names.
+func (a Aliases) Names() []string {
+	names := map[string]bool{}
+	for _, e := range a {
+		names[e.Alias] = true
+	}
+	return slices.Sorted(maps.Keys(names))
+}
+
+// Sort orders the aliases by the alias and the index name.
+func (a Aliases) Sort() {
+	slices.SortFunc(a, func(x, y Alias) int {
+		if c := strings.Compare(x.Alias, y.Alias); c != 0 {
+			return c
+		}
+		return strings.Compare(x.Index, y.Index)
+	})
+}
@@ -0,0 +1,27 @@
+package aliases
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAliases(t *testing.T) {
+	writeIndex := true
+	list := Aliases{
+		{Alias: "logs", Index: "logs-v2", IsWriteIndex: &writeIndex},
+		{Alias: "errors", Index: "logs-v1", Filter: json.RawMessage(`{ "term": { "level": "ERROR" } }`), SearchRouting: "1"},
+		{Alias: "logs", Index: "logs-v1"},
+	}
+	list.Sort()
+	assert.Equal(t, []string{"errors", "logs"}, list.Names())
+	assert.Equal(t, []string{"logs-v1", "logs-v2"}, list.Indices("logs"))
+	assert.Empty(t, list.Indices("missing"))
+	assert.Equal(t, map[string][]string{"logs-v1": {"errors", "logs"}, "logs-v2": {"logs"}}, list.ByIndex())
+	assert.Equal(t, [][]string{
+		{"errors", "logs-v1", "-", "*"},
+		{"logs", "logs-v1", "-", "-"},
+		{"logs", "logs-v2", "true", "-"},
+	}, list.TableRows(false))
+	assert.Equal(t, []string{"errors", "logs-v1", "-", "", "1", `{"term":{"level":"ERROR"}}`}, list.TableRows(true)[0])
+}
@@ -0,0 +1,96 @@
+package bulk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultBatchSize is the number of documents sent by the single _bulk request.
+	DefaultBatchSize = 500
+	// DefaultConcurrency is the number of _bulk requests sent in parallel.
+	DefaultConcurrency = 2
+	// DefaultMaxRetries is the number of retries of the documents rejected with 429 Too Many Requests.
+	DefaultMaxRetries = 5
+	// DefaultRetryBackoff is the delay before the first retry, it is doubled on every next one.
+	DefaultRetryBackoff = time.Second
+)
+
+// Options holds the parameters of the import.
+type Options struct {
+	// BatchSize is the number of documents sent by the single request, DefaultBatchSize if not set.
+	BatchSize int
+	// Concurrency is the number of requests sent in parallel, DefaultConcurrency if not set.
+	Concurrency int
+	// MaxRetries is the number of retries of the rejected documents, negative disables the retries.
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry, DefaultRetryBackoff if not set.
+	RetryBackoff time.Duration
+	// Refresh is the refresh mode of the _bulk requests, empty means the cluster default.
+	Refresh string
+}
+
+// WithDefaults returns the options with the unset values replaced by the defaults.
+func (o Options) WithDefaults() Options {
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultBatchSize
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = DefaultConcurrency
+	}
+	if o.MaxRetries < 0 {
+		o.MaxRetries = 0
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = DefaultRetryBackoff
+	}
+	return o
+}
+
+// Item is the single document of the import.
+type Item struct {
+	// Line is the number of the input line the document is read from, used in the failure reports.
+	Line    int
+	ID      string
+	Routing string
+	Source  json.RawMessage
+}
+
+// ParseLine parses the input line, which is either the document source indexed with the generated id,
+// or the hit exported by 'doc export' or 'search -o ndjson', whose _id and _routing are kept.
+func ParseLine(line int, data []byte) (Item, error) {
+	item := Item{Line: line}
+	data = bytes.TrimSpace(data)
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return item, fmt.Errorf("line is not a valid JSON object:%w", err)
+	}
+	source, exported := fields["_source"]
+	if !exported {
+		item.Source = data
+		return item, nil
+	}
+	item.Source = source
+	for key, target := range map[string]*string{"_id": &item.ID, "_routing": &item.Routing} {
+		if raw, ok := fields[key]; ok {
+			if err := json.Unmarshal(raw, target); err != nil {
+				return item, fmt.Errorf("%s is not a string:%w", key, err)
+			}
+		}
+	}
+	return item, nil
+}
+
+// Action returns the index action line of the item in the _bulk request.
+func (i Item) Action() ([]byte, error) {
+	meta := map[string]string{}
+	if i.ID != "" {
+		meta["_id"] = i.ID
+	}
+	if i.Routing != "" {
+		meta["routing"] = i.Routing
+	}
+	return json.Marshal(map[string]any{"index": meta})
+}
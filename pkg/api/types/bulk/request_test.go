@@ -0,0 +1,61 @@
+package bulk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		expected Item
+		wantErr  bool
+	}{
+		{
+			name:     "document source",
+			line:     `{"status":"new"}` + "\n",
+			expected: Item{Line: 3, Source: []byte(`{"status":"new"}`)},
+		},
+		{
+			name:     "exported hit",
+			line:     `{"_index":"orders","_id":"42","_routing":"c7","_source":{"status":"new"}}`,
+			expected: Item{Line: 3, ID: "42", Routing: "c7", Source: []byte(`{"status":"new"}`)},
+		},
+		{name: "not an object", line: `[1,2]`, wantErr: true},
+		{name: "invalid JSON", line: `{"status":`, wantErr: true},
+		{name: "id is not a string", line: `{"_id":42,"_source":{}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, err := ParseLine(3, []byte(tt.line))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected.ID, item.ID)
+			assert.Equal(t, tt.expected.Routing, item.Routing)
+			assert.Equal(t, tt.expected.Line, item.Line)
+			assert.JSONEq(t, string(tt.expected.Source), string(item.Source))
+		})
+	}
+}
+
+func TestItem_Action(t *testing.T) {
+	action, err := Item{}.Action()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"index":{}}`, string(action))
+	action, err = Item{ID: "42", Routing: "c7"}.Action()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"index":{"_id":"42","routing":"c7"}}`, string(action))
+}
+
+func TestOptions_WithDefaults(t *testing.T) {
+	opts := Options{MaxRetries: -1}.WithDefaults()
+	assert.Equal(t, Options{BatchSize: DefaultBatchSize, Concurrency: DefaultConcurrency, RetryBackoff: DefaultRetryBackoff}, opts)
+	opts = Options{BatchSize: 10, Concurrency: 4, MaxRetries: 2}.WithDefaults()
+	assert.Equal(t, 10, opts.BatchSize)
+	assert.Equal(t, 4, opts.Concurrency)
+	assert.Equal(t, 2, opts.MaxRetries)
+}
@@ -0,0 +1,67 @@
+package bulk
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Response represents the part of the _bulk response used by the import.
+type Response struct {
+	Errors bool `json:"errors"`
+	// Items holds the result of every action keyed by the action name, in the order of the request.
+	Items []map[string]ItemResult `json:"items"`
+}
+
+// ItemResult represents the result of the single action of the _bulk request.
+type ItemResult struct {
+	Index  string `json:"_index"`
+	ID     string `json:"_id"`
+	Status int    `json:"status"`
+	Error  *struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	} `json:"error,omitempty"`
+}
+
+// Failure describes the document which wasn't imported.
+type Failure struct {
+	Line   int    `json:"line"`
+	ID     string `json:"id,omitempty"`
+	Status int    `json:"status,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// Failures is the list of failed documents, it is rendered as a table.
+type Failures []Failure
+
+// TableHeader returns the column names of the failures table.
+func (f Failures) TableHeader(_ bool) []string {
+	return []string{"line", "id", "status", "reason"}
+}
+
+// TableRows returns a row per failed document.
+func (f Failures) TableRows(_ bool) [][]string {
+	rows := make([][]string, 0, len(f))
+	for _, failure := range f {
+		status := ""
+		if failure.Status != 0 {
+			status = strconv.Itoa(failure.Status)
+		}
+		rows = append(rows, []string{strconv.Itoa(failure.Line), failure.ID, status, failure.Reason})
+	}
+	return rows
+}
+
+// Summary holds the totals of the import.
+type Summary struct {
+	// Total is the number of the non-empty input lines.
+	Total    int      `json:"total"`
+	Indexed  int      `json:"indexed"`
+	Retries  int      `json:"retries"`
+	Failures Failures `json:"failures"`
+}
+
+// String returns the human-readable summary.
+func (s Summary) String() string {
+	return fmt.Sprintf("%d of %d documents imported, %d failed, %d retries", s.Indexed, s.Total, len(s.Failures), s.Retries)
+}
@@ -0,0 +1,29 @@
+package cluster
+
+import (
+	"fmt"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"slices"
+)
+
+const (
+	ScopePersistent = "persistent"
+	ScopeTransient  = "transient"
+	scopeDefaults   = "defaults"
+)
+
+// HealthStatuses holds the cluster health statuses from the best to the worst.
+var HealthStatuses = []string{"green", "yellow", "red"}
+
+// SettingsBody converts the key=value pairs to the body of the cluster settings update in the scope,
+// the empty value resets the setting to its default.
+func SettingsBody(pairs []string, scope string) (map[string]any, error) {
+	if !slices.Contains([]string{ScopePersistent, ScopeTransient}, scope) {
+		return nil, fmt.Errorf("unknown settings scope '%s'", scope)
+	}
+	settings, err := gu.ParseKeyValues(pairs)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{scope: settings}, nil
+}
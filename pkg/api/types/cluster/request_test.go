@@ -0,0 +1,20 @@
+package cluster
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSettingsBody(t *testing.T) {
+	body, err := SettingsBody([]string{"cluster.routing.allocation.enable=primaries", " indices.recovery.max_bytes_per_sec ="}, ScopeTransient)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{ScopeTransient: map[string]any{
+		"cluster.routing.allocation.enable":  "primaries",
+		"indices.recovery.max_bytes_per_sec": nil,
+	}}, body)
+
+	_, err = SettingsBody([]string{"no-value"}, ScopePersistent)
+	assert.Error(t, err)
+	_, err = SettingsBody([]string{"a=b"}, "defaults")
+	assert.Error(t, err)
+}
@@ -0,0 +1,301 @@
+package cluster
+
+import (
+	"fmt"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// healthIcons marks the health statuses in the tables.
+var healthIcons = map[string]string{"green": "✅", "yellow": "⚠️", "red": "❌"}
+
+// Health is the health of the cluster.
+type Health struct {
+	ClusterName                 string  `json:"cluster_name"`
+	Status                      string  `json:"status"`
+	TimedOut                    bool    `json:"timed_out"`
+	NumberOfNodes               int     `json:"number_of_nodes"`
+	NumberOfDataNodes           int     `json:"number_of_data_nodes"`
+	ActivePrimaryShards         int     `json:"active_primary_shards"`
+	ActiveShards                int     `json:"active_shards"`
+	RelocatingShards            int     `json:"relocating_shards"`
+	InitializingShards          int     `json:"initializing_shards"`
+	UnassignedShards            int     `json:"unassigned_shards"`
+	DelayedUnassignedShards     int     `json:"delayed_unassigned_shards"`
+	NumberOfPendingTasks        int     `json:"number_of_pending_tasks"`
+	NumberOfInFlightFetch       int     `json:"number_of_in_flight_fetch"`
+	TaskMaxWaitingInQueueMillis int     `json:"task_max_waiting_in_queue_millis"`
+	ActiveShardsPercentAsNumber float64 `json:"active_shards_percent_as_number"`
+}
+
+// TableHeader returns the column names of the health table.
+func (h Health) TableHeader(_ bool) []string {
+	return []string{"property", "value"}
+}
+
+// TableRows returns the health as property-value rows, the wide table includes the task queue details.
+func (h Health) TableRows(wide bool) [][]string {
+	rows := [][]string{
+		{"cluster", h.ClusterName},
+		{"status", healthIcons[h.Status] + h.Status},
+		{"nodes", fmt.Sprintf("%d, %d data", h.NumberOfNodes, h.NumberOfDataNodes)},
+		{"shards", fmt.Sprintf("%d active, %d primaries", h.ActiveShards, h.ActivePrimaryShards)},
+		{"relocating", strconv.Itoa(h.RelocatingShards)},
+		{"initializing", strconv.Itoa(h.InitializingShards)},
+		{"unassigned", fmt.Sprintf("%d, %d delayed", h.UnassignedShards, h.DelayedUnassignedShards)},
+		{"active shards", fmt.Sprintf("%.1f%%", h.ActiveShardsPercentAsNumber)},
+	}
+	if wide {
+		rows = append(rows,
+			[]string{"pending tasks", strconv.Itoa(h.NumberOfPendingTasks)},
+			[]string{"in flight fetch", strconv.Itoa(h.NumberOfInFlightFetch)},
+			[]string{"max task wait", fmt.Sprintf("%dms", h.TaskMaxWaitingInQueueMillis)},
+		)
+	}
+	if h.TimedOut {
+		rows = append(rows, []string{"timed out", "true"})
+	}
+	return rows
+}
+
+// NodeColumns holds the _cat/nodes columns of the Node.
+var NodeColumns = []string{
+	"name", "ip", "node.roles", "cluster_manager", "version", "uptime",
+	"heap.percent", "heap.max", "ram.percent", "cpu", "load_1m",
+	"disk.used_percent", "disk.avail", "disk.total",
+}
+
+// Node describes the node of the cluster, the values are formatted by the _cat/nodes API.
+type Node struct {
+	Name            string `json:"name"`
+	IP              string `json:"ip"`
+	Roles           string `json:"node.roles"`
+	ClusterManager  string `json:"cluster_manager"`
+	Version         string `json:"version"`
+	Uptime          string `json:"uptime"`
+	HeapPercent     string `json:"heap.percent"`
+	HeapMax         string `json:"heap.max"`
+	RAMPercent      string `json:"ram.percent"`
+	CPU             string `json:"cpu"`
+	Load1M          string `json:"load_1m"`
+	DiskUsedPercent string `json:"disk.used_percent"`
+	DiskAvail       string `json:"disk.avail"`
+	DiskTotal       string `json:"disk.total"`
+}
+
+// Nodes is the list of nodes, it is rendered as a table.
+type Nodes []Node
+
+// TableHeader returns the column names of the nodes table.
+func (n Nodes) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"name", "roles", "manager", "version", "heap%", "disk%", "cpu%", "ip", "uptime", "heap.max", "ram%", "load_1m", "disk.avail", "disk.total"}
+	}
+	return []string{"name", "roles", "manager", "version", "heap%", "disk%", "cpu%"}
+}
+
+// TableRows returns a row per node, the elected cluster manager is marked with '*'.
+func (n Nodes) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(n))
+	for _, node := range n {
+		row := []string{node.Name, node.Roles, node.ClusterManager, node.Version, node.HeapPercent, node.DiskUsedPercent, node.CPU}
+		if wide {
+			row = append(row, node.IP, node.Uptime, node.HeapMax, node.RAMPercent, node.Load1M, node.DiskAvail, node.DiskTotal)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ShardColumns holds the _cat/shards columns of the Shard.
+var ShardColumns = []string{"index", "shard", "prirep", "state", "docs", "store", "node", "unassigned.reason", "unassigned.for"}
+
+const (
+	ShardStarted      = "STARTED"
+	ShardUnassigned   = "UNASSIGNED"
+	ShardInitializing = "INITIALIZING"
+	ShardRelocating   = "RELOCATING"
+)
+
+// UnassignedAllocationFailed is the unassigned reason of the shard copy whose recovery, e.g. the restore from the snapshot, failed.
+const UnassignedAllocationFailed = "ALLOCATION_FAILED"
+
+// shardIcons highlights the shard copies which are not started.
+var shardIcons = map[string]string{ShardUnassigned: "❌", ShardInitializing: "⚠️", ShardRelocating: "⚠️"}
+
+// Shard describes the single shard copy, the values are formatted by the _cat/shards API.
+type Shard struct {
+	Index            string `json:"index"`
+	Shard            int    `json:"shard,string"`
+	Prirep           string `json:"prirep"`
+	State            string `json:"state"`
+	Docs             string `json:"docs,omitempty"`
+	Store            string `json:"store,omitempty"`
+	Node             string `json:"node,omitempty"`
+	UnassignedReason string `json:"unassigned.reason,omitempty"`
+	UnassignedFor    string `json:"unassigned.for,omitempty"`
+}
+
+// Shards is the list of shard copies, it is rendered as a table.
+type Shards []Shard
+
+// TableHeader returns the column names of the shards table.
+func (s Shards) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"index", "shard", "prirep", "state", "docs", "store", "node", "unassigned.reason", "unassigned.for"}
+	}
+	return []string{"index", "shard", "prirep", "state", "docs", "store", "node"}
+}
+
+// TableRows returns a row per shard copy, the copies which are not started are marked with the icon.
+func (s Shards) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(s))
+	for _, shard := range s {
+		row := []string{shard.Index, strconv.Itoa(shard.Shard), shard.Prirep, shardIcons[shard.State] + shard.State, shard.Docs, shard.Store, shard.Node}
+		if wide {
+			row = append(row, shard.UnassignedReason, shard.UnassignedFor)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// Sort orders the shards by the index, the shard number and primaries first.
+func (s Shards) Sort() {
+	slices.SortFunc(s, func(a, b Shard) int {
+		if c := strings.Compare(a.Index, b.Index); c != 0 {
+			return c
+		} else if a.Shard != b.Shard {
+			return a.Shard - b.Shard
+		}
+		return strings.Compare(a.Prirep, b.Prirep)
+	})
+}
+
+// Count returns the number of the shard copies in the state.
+func (s Shards) Count(state string) int {
+	count := 0
+	for _, shard := range s {
+		if shard.State == state {
+			count++
+		}
+	}
+	return count
+}
+
+// FailedPrimaries returns the primary shards left unassigned because their recovery failed.
+func (s Shards) FailedPrimaries() Shards {
+	var failed Shards
+	for _, shard := range s {
+		if shard.Prirep == "p" && shard.State == ShardUnassigned && shard.UnassignedReason == UnassignedAllocationFailed {
+			failed = append(failed, shard)
+		}
+	}
+	return failed
+}
+
+// Decider is the decision of the single allocation decider.
+type Decider struct {
+	Decider     string `json:"decider"`
+	Decision    string `json:"decision"`
+	Explanation string `json:"explanation"`
+}
+
+// NodeDecision is the allocation decision of the shard copy for the single node.
+type NodeDecision struct {
+	NodeName     string    `json:"node_name"`
+	NodeDecision string    `json:"node_decision"`
+	Deciders     []Decider `json:"deciders,omitempty"`
+}
+
+// AllocationExplanation explains why the shard copy is unassigned or can't be moved to or rebalanced.
+type AllocationExplanation struct {
+	Index        string `json:"index"`
+	Shard        int    `json:"shard"`
+	Primary      bool   `json:"primary"`
+	CurrentState string `json:"current_state"`
+	CurrentNode  *struct {
+		Name string `json:"name"`
+	} `json:"current_node,omitempty"`
+	UnassignedInfo *struct {
+		Reason               string `json:"reason"`
+		At                   string `json:"at"`
+		LastAllocationStatus string `json:"last_allocation_status,omitempty"`
+		Details              string `json:"details,omitempty"`
+	} `json:"unassigned_info,omitempty"`
+	CanAllocate             string         `json:"can_allocate,omitempty"`
+	AllocateExplanation     string         `json:"allocate_explanation,omitempty"`
+	CanRemainOnCurrentNode  string         `json:"can_remain_on_current_node,omitempty"`
+	CanRebalanceCluster     string         `json:"can_rebalance_cluster,omitempty"`
+	RebalanceExplanation    string         `json:"rebalance_explanation,omitempty"`
+	NodeAllocationDecisions []NodeDecision `json:"node_allocation_decisions,omitempty"`
+}
+
+// TableHeader returns the column names of the allocation explanation table.
+func (a AllocationExplanation) TableHeader(_ bool) []string {
+	return []string{"property", "value"}
+}
+
+// TableRows returns the explanation as property-value rows followed by the decision of every node,
+// the narrow table shows only the explanations of the deciders which said no.
+func (a AllocationExplanation) TableRows(wide bool) [][]string {
+	rows := [][]string{
+		{"index", a.Index},
+		{"shard", strconv.Itoa(a.Shard)},
+		{"primary", strconv.FormatBool(a.Primary)},
+		{"state", shardIcons[strings.ToUpper(a.CurrentState)] + a.CurrentState},
+	}
+	if a.CurrentNode != nil {
+		rows = append(rows, []string{"node", a.CurrentNode.Name})
+	}
+	if a.UnassignedInfo != nil {
+		rows = append(rows, []string{"unassigned", fmt.Sprintf("%s at %s", a.UnassignedInfo.Reason, a.UnassignedInfo.At)})
+		if a.UnassignedInfo.Details != "" {
+			rows = append(rows, []string{"details", a.UnassignedInfo.Details})
+		}
+	}
+	for _, kv := range [][]string{
+		{"can allocate", a.CanAllocate},
+		{"allocate explanation", a.AllocateExplanation},
+		{"can remain", a.CanRemainOnCurrentNode},
+		{"can rebalance", a.CanRebalanceCluster},
+		{"rebalance explanation", a.RebalanceExplanation},
+	} {
+		if kv[1] != "" {
+			rows = append(rows, kv)
+		}
+	}
+	for _, node := range a.NodeAllocationDecisions {
+		explanations := []string{node.NodeDecision}
+		for _, d := range node.Deciders {
+			if wide || d.Decision == "NO" {
+				explanations = append(explanations, fmt.Sprintf("[%s %s] %s", d.Decider, d.Decision, d.Explanation))
+			}
+		}
+		rows = append(rows, []string{"node " + node.NodeName, strings.Join(explanations, " ")})
+	}
+	return rows
+}
+
+// Settings holds the cluster settings grouped by the scope: persistent, transient and defaults.
+type Settings map[string]any
+
+// TableHeader returns the column names of the settings table.
+func (s Settings) TableHeader(_ bool) []string {
+	return []string{"scope", "setting", "value"}
+}
+
+// TableRows returns a row per flat setting, the persistent settings go first.
+func (s Settings) TableRows(_ bool) [][]string {
+	var rows [][]string
+	for _, scope := range []string{ScopePersistent, ScopeTransient, scopeDefaults} {
+		flat := gu.FlattenValues(s[scope])
+		for _, key := range slices.Sorted(maps.Keys(flat)) {
+			rows = append(rows, []string{scope, key, flat[key]})
+		}
+	}
+	return rows
+}
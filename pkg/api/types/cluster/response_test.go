@@ -0,0 +1,60 @@
+package cluster
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestShards(t *testing.T) {
+	var shards Shards
+	assert.NoError(t, json.Unmarshal([]byte(`[
+		{"index":"b","shard":"0","prirep":"r","state":"UNASSIGNED","unassigned.reason":"NODE_LEFT","unassigned.for":"5m"},
+		{"index":"b","shard":"0","prirep":"p","state":"STARTED","docs":"10","store":"1kb","node":"n1"},
+		{"index":"a","shard":"1","prirep":"p","state":"RELOCATING","node":"n1 -> n2"}
+	]`), &shards))
+	shards.Sort()
+	assert.Equal(t, 1, shards.Count(ShardUnassigned))
+	assert.Equal(t, [][]string{
+		{"a", "1", "p", "⚠️RELOCATING", "", "", "n1 -> n2"},
+		{"b", "0", "p", "STARTED", "10", "1kb", "n1"},
+		{"b", "0", "r", "❌UNASSIGNED", "", "", ""},
+	}, shards.TableRows(false))
+	assert.Equal(t, []string{"NODE_LEFT", "5m"}, shards.TableRows(true)[2][7:])
+	assert.Empty(t, shards.FailedPrimaries(), "unassigned replica isn't the failed primary")
+
+	shards = append(shards,
+		Shard{Index: "c", Shard: 0, Prirep: "p", State: ShardUnassigned, UnassignedReason: UnassignedAllocationFailed},
+		Shard{Index: "c", Shard: 1, Prirep: "p", State: ShardUnassigned, UnassignedReason: "NEW_INDEX_RESTORED"})
+	assert.Equal(t, Shards{shards[3]}, shards.FailedPrimaries())
+}
+
+func TestSettings_TableRows(t *testing.T) {
+	var settings Settings
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"persistent": {"cluster": {"routing": {"allocation": {"enable": "primaries"}}, "remote": {"leader": {"seeds": ["a:9300"]}}}},
+		"transient": {}
+	}`), &settings))
+	assert.Equal(t, [][]string{
+		{"persistent", "cluster.remote.leader.seeds", `["a:9300"]`},
+		{"persistent", "cluster.routing.allocation.enable", "primaries"},
+	}, settings.TableRows(false))
+}
+
+func TestAllocationExplanation_TableRows(t *testing.T) {
+	var explanation AllocationExplanation
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"index": "orders", "shard": 0, "primary": false, "current_state": "unassigned",
+		"unassigned_info": {"reason": "NODE_LEFT", "at": "2024-01-01T00:00:00Z"},
+		"can_allocate": "no", "allocate_explanation": "cannot allocate",
+		"node_allocation_decisions": [{"node_name": "n1", "node_decision": "no", "deciders": [
+			{"decider": "same_shard", "decision": "NO", "explanation": "copy is already allocated"},
+			{"decider": "disk_threshold", "decision": "YES", "explanation": "enough disk"}
+		]}]
+	}`), &explanation))
+	rows := explanation.TableRows(false)
+	assert.Equal(t, []string{"state", "❌unassigned"}, rows[3])
+	assert.Equal(t, []string{"unassigned", "NODE_LEFT at 2024-01-01T00:00:00Z"}, rows[4])
+	assert.Equal(t, []string{"node n1", "no [same_shard NO] copy is already allocated"}, rows[len(rows)-1])
+	assert.Contains(t, explanation.TableRows(true)[len(rows)-1][1], "[disk_threshold YES] enough disk")
+}
@@ -0,0 +1,80 @@
+package doctor
+
+import (
+	"fmt"
+)
+
+const (
+	// StatusPass the check passed.
+	StatusPass = "pass"
+	// StatusFail the check failed.
+	StatusFail = "fail"
+	// StatusSkip the check was not run because the check it depends on failed.
+	StatusSkip = "skip"
+)
+
+// Check represents the result of the single diagnostic check.
+type Check struct {
+	// Name of the check, e.g. config, dns, tcp, tls, auth, info, plugins.
+	Name string `json:"name"`
+	// Target the check was run against, e.g. the config entry or the server address.
+	Target string `json:"target"`
+	// Status of the check, one of StatusPass, StatusFail, StatusSkip.
+	Status string `json:"status"`
+	// Details holds the result of the passed check or the reason of the failure.
+	Details string `json:"details,omitempty"`
+}
+
+// Report represents the results of all the diagnostic checks of the context.
+type Report struct {
+	// Context is the name of the diagnosed context.
+	Context string `json:"context"`
+	// Checks holds the results in the order they were run.
+	Checks []Check `json:"checks"`
+}
+
+// Add appends the check result to the report.
+func (r *Report) Add(name, target, status, details string) {
+	r.Checks = append(r.Checks, Check{Name: name, Target: target, Status: status, Details: details})
+}
+
+// Pass appends the passed check to the report.
+func (r *Report) Pass(name, target, format string, args ...interface{}) {
+	r.Add(name, target, StatusPass, fmt.Sprintf(format, args...))
+}
+
+// Fail appends the failed check to the report.
+func (r *Report) Fail(name, target string, err error) {
+	r.Add(name, target, StatusFail, err.Error())
+}
+
+// Skip appends the skipped check to the report.
+func (r *Report) Skip(name, target, reason string) {
+	r.Add(name, target, StatusSkip, reason)
+}
+
+// Failed returns the number of the failed checks.
+func (r Report) Failed() int {
+	failed := 0
+	for _, c := range r.Checks {
+		if c.Status == StatusFail {
+			failed++
+		}
+	}
+	return failed
+}
+
+// TableHeader returns the column names of the report table.
+func (r Report) TableHeader(wide bool) []string {
+	return []string{"check", "target", "status", "details"}
+}
+
+// TableRows returns a row per check.
+func (r Report) TableRows(wide bool) [][]string {
+	icons := map[string]string{StatusPass: "✅", StatusFail: "❌", StatusSkip: "➖"}
+	rows := make([][]string, 0, len(r.Checks))
+	for _, c := range r.Checks {
+		rows = append(rows, []string{c.Name, c.Target, icons[c.Status] + c.Status, c.Details})
+	}
+	return rows
+}
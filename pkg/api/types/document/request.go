@@ -0,0 +1,87 @@
+package document
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// RefreshTrue refreshes the affected shards right after the operation.
+	RefreshTrue = "true"
+	// RefreshFalse doesn't refresh the shards(default), the change becomes visible with the next periodic refresh.
+	RefreshFalse = "false"
+	// RefreshWaitFor waits for the next periodic refresh before returning.
+	RefreshWaitFor = "wait_for"
+)
+
+// RefreshModes holds the list of supported refresh modes.
+var RefreshModes = []string{RefreshTrue, RefreshFalse, RefreshWaitFor}
+
+// Options holds the parameters shared by the single document operations.
+type Options struct {
+	// Routing is the custom routing value the document was indexed with.
+	Routing string
+	// Refresh is one of RefreshModes, empty means the cluster default.
+	Refresh string
+	// IfSeqNo and IfPrimaryTerm make the write fail if the document was changed since it was read,
+	// they must be set together.
+	IfSeqNo       *int
+	IfPrimaryTerm *int
+}
+
+// Validate checks that the optimistic concurrency parameters are set together and are not negative.
+func (o Options) Validate() error {
+	if (o.IfSeqNo == nil) != (o.IfPrimaryTerm == nil) {
+		return errors.New("if_seq_no and if_primary_term must be set together")
+	}
+	if o.IfSeqNo != nil && (*o.IfSeqNo < 0 || *o.IfPrimaryTerm < 0) {
+		return errors.New("if_seq_no and if_primary_term must not be negative")
+	}
+	return nil
+}
+
+// RefreshOnRead returns the refresh parameter of the read operations which only support the boolean value.
+func (o Options) RefreshOnRead() *bool {
+	if o.Refresh == "" {
+		return nil
+	}
+	refresh := o.Refresh != RefreshFalse
+	return &refresh
+}
+
+// UpdateBody returns the body of the update request from the partial document or the script, exactly one must be set.
+// The script source is run with 'painless' lang unless the lang is set, params are passed to the script.
+// upsert indexes the partial document(doc_as_upsert) or the upsert document of the script when the document is missing.
+func UpdateBody(doc json.RawMessage, script, lang string, params map[string]any, upsert bool) ([]byte, error) {
+	body := map[string]any{}
+	switch {
+	case len(doc) > 0 && script != "":
+		return nil, errors.New("partial document and script are mutually exclusive")
+	case len(doc) > 0:
+		var partial map[string]any
+		if err := json.Unmarshal(doc, &partial); err != nil {
+			return nil, fmt.Errorf("partial document is not a valid JSON object:%w", err)
+		}
+		body["doc"] = partial
+		if upsert {
+			body["doc_as_upsert"] = true
+		}
+	case script != "":
+		s := map[string]any{"source": script, "lang": "painless"}
+		if lang != "" {
+			s["lang"] = lang
+		}
+		if len(params) > 0 {
+			s["params"] = params
+		}
+		body["script"] = s
+		if upsert {
+			body["scripted_upsert"] = true
+			body["upsert"] = map[string]any{}
+		}
+	default:
+		return nil, errors.New("either partial document or script is required")
+	}
+	return json.Marshal(body)
+}
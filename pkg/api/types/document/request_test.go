@@ -0,0 +1,83 @@
+package document
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOptions_Validate(t *testing.T) {
+	seqNo, primaryTerm, negative := 3, 1, -1
+	assert.NoError(t, Options{}.Validate())
+	assert.NoError(t, Options{IfSeqNo: &seqNo, IfPrimaryTerm: &primaryTerm}.Validate())
+	assert.Error(t, Options{IfSeqNo: &seqNo}.Validate())
+	assert.Error(t, Options{IfPrimaryTerm: &primaryTerm}.Validate())
+	assert.Error(t, Options{IfSeqNo: &negative, IfPrimaryTerm: &primaryTerm}.Validate())
+}
+
+func TestOptions_RefreshOnRead(t *testing.T) {
+	assert.Nil(t, Options{}.RefreshOnRead())
+	assert.False(t, *Options{Refresh: RefreshFalse}.RefreshOnRead())
+	assert.True(t, *Options{Refresh: RefreshTrue}.RefreshOnRead())
+	assert.True(t, *Options{Refresh: RefreshWaitFor}.RefreshOnRead())
+}
+
+func TestUpdateBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		script   string
+		lang     string
+		params   map[string]any
+		upsert   bool
+		expected map[string]any
+		wantErr  bool
+	}{
+		{
+			name:     "partial document",
+			doc:      `{"status":"shipped"}`,
+			expected: map[string]any{"doc": map[string]any{"status": "shipped"}},
+		},
+		{
+			name:     "partial document as upsert",
+			doc:      `{"status":"shipped"}`,
+			upsert:   true,
+			expected: map[string]any{"doc": map[string]any{"status": "shipped"}, "doc_as_upsert": true},
+		},
+		{
+			name:   "script with params",
+			script: "ctx._source.n += params.n",
+			params: map[string]any{"n": 1.0},
+			expected: map[string]any{
+				"script": map[string]any{"source": "ctx._source.n += params.n", "lang": "painless", "params": map[string]any{"n": 1.0}},
+			},
+		},
+		{
+			name:   "scripted upsert",
+			script: "ctx._source.n = 1",
+			lang:   "expression",
+			upsert: true,
+			expected: map[string]any{
+				"script":          map[string]any{"source": "ctx._source.n = 1", "lang": "expression"},
+				"scripted_upsert": true,
+				"upsert":          map[string]any{},
+			},
+		},
+		{name: "neither document nor script", wantErr: true},
+		{name: "both document and script", doc: `{}`, script: "x", wantErr: true},
+		{name: "document is not an object", doc: `[1]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := UpdateBody(json.RawMessage(tt.doc), tt.script, tt.lang, tt.params, tt.upsert)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			var actual map[string]any
+			assert.NoError(t, json.Unmarshal(body, &actual))
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
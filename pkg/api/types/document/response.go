@@ -0,0 +1,65 @@
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Document represents the document returned by the get request.
+type Document struct {
+	Index       string          `json:"_index"`
+	ID          string          `json:"_id"`
+	Version     int             `json:"_version"`
+	SeqNo       int             `json:"_seq_no"`
+	PrimaryTerm int             `json:"_primary_term"`
+	Routing     string          `json:"_routing,omitempty"`
+	Found       bool            `json:"found"`
+	Source      json.RawMessage `json:"_source,omitempty"`
+}
+
+// TableHeader returns the column names of the document table.
+func (d Document) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"index", "id", "version", "seq_no", "primary_term", "routing", "source"}
+	}
+	return []string{"index", "id", "version", "seq_no", "primary_term", "source"}
+}
+
+// TableRows returns the single row of the document.
+func (d Document) TableRows(wide bool) [][]string {
+	row := []string{d.Index, d.ID, strconv.Itoa(d.Version), strconv.Itoa(d.SeqNo), strconv.Itoa(d.PrimaryTerm)}
+	if wide {
+		row = append(row, d.Routing)
+	}
+	return [][]string{append(row, compact(d.Source))}
+}
+
+// compact returns the JSON without the insignificant whitespace, the source is kept as sent by the client.
+func compact(raw json.RawMessage) string {
+	buf := bytes.Buffer{}
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
+
+// Result represents the outcome of the index, update and delete requests.
+type Result struct {
+	Index       string `json:"_index"`
+	ID          string `json:"_id"`
+	Version     int    `json:"_version"`
+	Result      string `json:"result"`
+	SeqNo       int    `json:"_seq_no"`
+	PrimaryTerm int    `json:"_primary_term"`
+}
+
+// TableHeader returns the column names of the result table.
+func (r Result) TableHeader(_ bool) []string {
+	return []string{"index", "id", "result", "version", "seq_no", "primary_term"}
+}
+
+// TableRows returns the single row of the result.
+func (r Result) TableRows(_ bool) [][]string {
+	return [][]string{{r.Index, r.ID, r.Result, strconv.Itoa(r.Version), strconv.Itoa(r.SeqNo), strconv.Itoa(r.PrimaryTerm)}}
+}
@@ -0,0 +1,47 @@
+package indices
+
+import (
+	"encoding/json"
+	"fmt"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"strings"
+)
+
+// SettingsBody converts the key=value pairs to the flat settings update body,
+// the keys without the 'index.' prefix are prefixed, e.g. number_of_replicas=2 sets index.number_of_replicas.
+// The empty value resets the setting to its default.
+func SettingsBody(pairs []string) (map[string]any, error) {
+	settings, err := gu.ParseKeyValues(pairs)
+	if err != nil {
+		return nil, err
+	}
+	body := make(map[string]any, len(settings))
+	for k, v := range settings {
+		if !strings.HasPrefix(k, "index.") {
+			k = "index." + k
+		}
+		body[k] = v
+	}
+	return body, nil
+}
+
+// CreateBody combines the settings and mappings documents into the body of the create index request.
+// Both documents may be either wrapped into the 'settings' and 'mappings' keys or not, the empty ones are skipped.
+func CreateBody(settings, mappings []byte) ([]byte, error) {
+	body := map[string]json.RawMessage{}
+	for key, doc := range map[string][]byte{"settings": settings, "mappings": mappings} {
+		if len(doc) == 0 {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(doc, &fields); err != nil {
+			return nil, fmt.Errorf("%s is not a valid JSON object:%w", key, err)
+		}
+		if wrapped, ok := fields[key]; ok && len(fields) == 1 {
+			body[key] = wrapped
+		} else {
+			body[key] = doc
+		}
+	}
+	return json.Marshal(body)
+}
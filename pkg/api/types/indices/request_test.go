@@ -0,0 +1,56 @@
+package indices
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSettingsBody(t *testing.T) {
+	body, err := SettingsBody([]string{"number_of_replicas=2", "index.refresh_interval=30s", "blocks.write="})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"index.number_of_replicas": "2",
+		"index.refresh_interval":   "30s",
+		"index.blocks.write":       nil,
+	}, body)
+	_, err = SettingsBody([]string{"number_of_replicas"})
+	assert.Error(t, err)
+	_, err = SettingsBody([]string{"=2"})
+	assert.Error(t, err)
+}
+
+func TestCreateBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings string
+		mappings string
+		expected string
+		wantErr  bool
+	}{
+		{name: "empty", expected: `{}`},
+		{
+			name:     "unwrapped documents",
+			settings: `{"number_of_shards":1}`,
+			mappings: `{"properties":{"status":{"type":"keyword"}}}`,
+			expected: `{"settings":{"number_of_shards":1},"mappings":{"properties":{"status":{"type":"keyword"}}}}`,
+		},
+		{
+			name:     "wrapped documents",
+			settings: `{"settings":{"number_of_shards":1}}`,
+			mappings: `{"mappings":{"dynamic":"strict"}}`,
+			expected: `{"settings":{"number_of_shards":1},"mappings":{"dynamic":"strict"}}`,
+		},
+		{name: "invalid settings", settings: `{`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := CreateBody([]byte(tt.settings), []byte(tt.mappings))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(body))
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package indices
+
+import (
+	"encoding/json"
+	"fmt"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IndexSettings holds the flat settings of the single index.
+type IndexSettings struct {
+	Settings map[string]any `json:"settings"`
+	Defaults map[string]any `json:"defaults,omitempty"`
+}
+
+// Settings holds the settings of the indices keyed by the index name.
+type Settings map[string]IndexSettings
+
+// TableHeader returns the column names of the settings table.
+func (s Settings) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"index", "setting", "value", "source"}
+	}
+	return []string{"index", "setting", "value"}
+}
+
+// TableRows returns a row per setting sorted by the index and the setting name, the defaults follow the explicit settings.
+func (s Settings) TableRows(wide bool) [][]string {
+	var rows [][]string
+	for _, index := range slices.Sorted(maps.Keys(s)) {
+		for _, group := range []struct {
+			source   string
+			settings map[string]any
+		}{{"index", s[index].Settings}, {"default", s[index].Defaults}} {
+			for _, key := range slices.Sorted(maps.Keys(group.settings)) {
+				row := []string{index, key, gu.FormatValue(group.settings[key])}
+				if wide {
+					row = append(row, group.source)
+				}
+				rows = append(rows, row)
+			}
+		}
+	}
+	return rows
+}
+
+// IndexMappings holds the mappings of the single index.
+type IndexMappings struct {
+	Mappings json.RawMessage `json:"mappings"`
+}
+
+// Mappings holds the mappings of the indices keyed by the index name.
+type Mappings map[string]IndexMappings
+
+// TableHeader returns the column names of the mapping fields table.
+func (m Mappings) TableHeader(_ bool) []string {
+	return []string{"index", "field", "type"}
+}
+
+// TableRows returns a row per mapped field of every index.
+func (m Mappings) TableRows(_ bool) [][]string {
+	var rows [][]string
+	for _, index := range slices.Sorted(maps.Keys(m)) {
+		fields, _ := MappingFields(m[index].Mappings)
+		for _, f := range fields {
+			rows = append(rows, []string{index, f.Field, f.Type})
+		}
+	}
+	return rows
+}
+
+// MappingField is the single field of the mappings with its full dotted path.
+type MappingField struct {
+	Field string `json:"field"`
+	Type  string `json:"type"`
+}
+
+// mappingProperty is the part of the mapping property definition used to list the fields.
+type mappingProperty struct {
+	Type       string                     `json:"type"`
+	Properties map[string]mappingProperty `json:"properties"`
+	Fields     map[string]mappingProperty `json:"fields"`
+}
+
+// MappingFields returns the fields of the mappings sorted by their path, including the object fields and the multi-fields.
+func MappingFields(mappings json.RawMessage) ([]MappingField, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
+	var root mappingProperty
+	if err := json.Unmarshal(mappings, &root); err != nil {
+		return nil, err
+	}
+	var fields []MappingField
+	var walk func(prefix string, properties map[string]mappingProperty)
+	walk = func(prefix string, properties map[string]mappingProperty) {
+		for _, name := range slices.Sorted(maps.Keys(properties)) {
+			p, path := properties[name], prefix+name
+			fieldType := p.Type
+			if fieldType == "" {
+				fieldType = "object"
+			}
+			fields = append(fields, MappingField{Field: path, Type: fieldType})
+			walk(path+".", p.Fields)
+			walk(path+".", p.Properties)
+		}
+	}
+	walk("", root.Properties)
+	return fields, nil
+}
+
+// Shard describes the single shard copy of the index.
+type Shard struct {
+	Shard            int    `json:"shard"`
+	Prirep           string `json:"prirep"`
+	State            string `json:"state"`
+	Docs             string `json:"docs,omitempty"`
+	Store            string `json:"store,omitempty"`
+	Node             string `json:"node,omitempty"`
+	UnassignedReason string `json:"unassignedReason,omitempty"`
+}
+
+// Shards is the list of the shard copies, it is rendered as a table.
+type Shards []Shard
+
+// TableHeader returns the column names of the shards table.
+func (s Shards) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"shard", "prirep", "state", "docs", "store", "node", "unassigned.reason"}
+	}
+	return []string{"shard", "prirep", "state", "docs", "store", "node"}
+}
+
+// TableRows returns a row per shard copy.
+func (s Shards) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(s))
+	for _, shard := range s {
+		row := []string{strconv.Itoa(shard.Shard), shard.Prirep, shard.State, shard.Docs, shard.Store, shard.Node}
+		if wide {
+			row = append(row, shard.UnassignedReason)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// Description combines the state, settings, mappings, aliases and shard allocation of the index.
+type Description struct {
+	Name         string          `json:"name"`
+	UUID         string          `json:"uuid"`
+	Health       string          `json:"health"`
+	Status       string          `json:"status"`
+	Primaries    string          `json:"primaries"`
+	Replicas     string          `json:"replicas"`
+	DocsCount    string          `json:"docsCount"`
+	DocsDeleted  string          `json:"docsDeleted"`
+	StoreSize    string          `json:"storeSize"`
+	PriStoreSize string          `json:"priStoreSize"`
+	CreationDate string          `json:"creationDate,omitempty"`
+	Aliases      []string        `json:"aliases"`
+	Settings     map[string]any  `json:"settings"`
+	Mappings     json.RawMessage `json:"mappings"`
+	Shards       Shards          `json:"shards"`
+}
+
+// TableHeader returns the column names of the index overview table.
+func (d Description) TableHeader(_ bool) []string {
+	return []string{"property", "value"}
+}
+
+// TableRows returns the overview of the index as property-value rows, the wide table includes all the settings.
+func (d Description) TableRows(wide bool) [][]string {
+	rows := [][]string{
+		{"name", d.Name},
+		{"uuid", d.UUID},
+		{"health", d.Health},
+		{"status", d.Status},
+		{"created", d.CreationDate},
+		{"shards", fmt.Sprintf("%s primaries, %s replicas", d.Primaries, d.Replicas)},
+		{"docs", fmt.Sprintf("%s, %s deleted", d.DocsCount, d.DocsDeleted)},
+		{"size", fmt.Sprintf("%s, %s primaries", d.StoreSize, d.PriStoreSize)},
+		{"aliases", strings.Join(d.Aliases, ", ")},
+	}
+	for _, key := range slices.Sorted(maps.Keys(d.Settings)) {
+		if wide || slices.Contains(overviewSettings, key) {
+			rows = append(rows, []string{key, gu.FormatValue(d.Settings[key])})
+		}
+	}
+	return rows
+}
+
+// overviewSettings holds the dynamic settings shown in the table overview.
+var overviewSettings = []string{
+	"index.refresh_interval",
+	"index.number_of_replicas",
+	"index.auto_expand_replicas",
+	"index.blocks.write",
+	"index.blocks.read_only_allow_delete",
+	"index.plugins.index_state_management.policy_id",
+}
+
+// FormatCreationDate converts the index.creation_date setting(epoch milliseconds) to RFC 3339, empty if it is not valid.
+func FormatCreationDate(value any) string {
+	s, _ := value.(string)
+	millis, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return ""
+	}
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
+
+const (
+	// ResultDone the operation succeeded.
+	ResultDone = "done"
+	// ResultFailed the operation failed.
+	ResultFailed = "failed"
+	// ResultSkipped the operation was not attempted.
+	ResultSkipped = "skipped"
+)
+
+// OperationResult is the outcome of the operation on the single index of the batch.
+type OperationResult struct {
+	Index     string `json:"index"`
+	Operation string `json:"operation"`
+	// Status of the operation, one of ResultDone, ResultFailed, ResultSkipped.
+	Status string `json:"status"`
+	// Details holds the reason of the failure or of the skip.
+	Details string `json:"details,omitempty"`
+}
+
+// OperationResults is the summary of the batch operation, it is rendered as a table.
+type OperationResults []OperationResult
+
+// Count returns the number of the results with the status.
+func (r OperationResults) Count(status string) int {
+	count := 0
+	for _, result := range r {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// TableHeader returns the column names of the summary table.
+func (r OperationResults) TableHeader(_ bool) []string {
+	return []string{"index", "operation", "status", "details"}
+}
+
+// TableRows returns a row per index, the multi-line details(e.g. the error responses) are joined into the single line.
+func (r OperationResults) TableRows(_ bool) [][]string {
+	icons := map[string]string{ResultDone: "✅", ResultFailed: "❌", ResultSkipped: "➖"}
+	rows := make([][]string, 0, len(r))
+	for _, result := range r {
+		details := strings.Join(strings.Fields(result.Details), " ")
+		rows = append(rows, []string{result.Index, result.Operation, icons[result.Status] + result.Status, details})
+	}
+	return rows
+}
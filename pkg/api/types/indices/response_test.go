@@ -0,0 +1,71 @@
+package indices
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMappingFields(t *testing.T) {
+	mappings := json.RawMessage(`{
+		"dynamic": "strict",
+		"properties": {
+			"title": {"type": "text", "fields": {"raw": {"type": "keyword"}}},
+			"customer": {"properties": {"name": {"type": "text"}, "id": {"type": "long"}}},
+			"items": {"type": "nested", "properties": {"sku": {"type": "keyword"}}}
+		}
+	}`)
+	fields, err := MappingFields(mappings)
+	assert.NoError(t, err)
+	assert.Equal(t, []MappingField{
+		{Field: "customer", Type: "object"},
+		{Field: "customer.id", Type: "long"},
+		{Field: "customer.name", Type: "text"},
+		{Field: "items", Type: "nested"},
+		{Field: "items.sku", Type: "keyword"},
+		{Field: "title", Type: "text"},
+		{Field: "title.raw", Type: "keyword"},
+	}, fields)
+
+	fields, err = MappingFields(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, fields)
+}
+
+func TestSettings_TableRows(t *testing.T) {
+	settings := Settings{
+		"b": {Settings: map[string]any{"index.number_of_replicas": "1"}},
+		"a": {
+			Settings: map[string]any{"index.refresh_interval": "30s", "index.number_of_replicas": "2"},
+			Defaults: map[string]any{"index.routing.allocation.include._tier_preference": []any{"data_hot", "data_content"}},
+		},
+	}
+	assert.Equal(t, [][]string{
+		{"a", "index.number_of_replicas", "2"},
+		{"a", "index.refresh_interval", "30s"},
+		{"a", "index.routing.allocation.include._tier_preference", "data_hot,data_content"},
+		{"b", "index.number_of_replicas", "1"},
+	}, settings.TableRows(false))
+	assert.Equal(t, []string{"a", "index.routing.allocation.include._tier_preference", "data_hot,data_content", "default"},
+		settings.TableRows(true)[2])
+}
+
+func TestFormatCreationDate(t *testing.T) {
+	assert.Equal(t, "2024-01-02T03:04:05Z", FormatCreationDate("1704164645000"))
+	assert.Equal(t, "", FormatCreationDate(nil))
+	assert.Equal(t, "", FormatCreationDate("yesterday"))
+}
+
+func TestOperationResults_TableRows(t *testing.T) {
+	results := OperationResults{
+		{Index: "a", Operation: "delete", Status: ResultDone},
+		{Index: "b", Operation: "delete", Status: ResultFailed, Details: "code: 404\nresponse: {\n  \"status\": 404\n}"},
+		{Index: "c", Operation: "delete", Status: ResultSkipped, Details: "protected by 'c*'"},
+	}
+	assert.Equal(t, 1, results.Count(ResultFailed))
+	assert.Equal(t, [][]string{
+		{"a", "delete", "✅done", ""},
+		{"b", "delete", "❌failed", `code: 404 response: { "status": 404 }`},
+		{"c", "delete", "➖skipped", "protected by 'c*'"},
+	}, results.TableRows(false))
+}
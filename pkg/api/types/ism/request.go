@@ -0,0 +1,187 @@
+package ism
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/opensearch-project/opensearch-go/v4"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// policiesPath is the path of the ISM policies API.
+const policiesPath = "/_plugins/_ism/policies"
+
+// ListPoliciesReq request type for https://docs.opensearch.org/2.19/im-plugin/ism/api/#get-policies
+type ListPoliciesReq struct {
+	Header http.Header
+	// From is the offset of the first returned policy.
+	From int
+	// Size is the maximal number of the returned policies.
+	Size int
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r ListPoliciesReq) GetRequest() (*http.Request, error) {
+	params := make(map[string]string)
+	if r.From > 0 {
+		params["from"] = strconv.Itoa(r.From)
+	}
+	if r.Size > 0 {
+		params["size"] = strconv.Itoa(r.Size)
+	}
+	return opensearch.BuildRequest(
+		"GET",
+		policiesPath,
+		nil,
+		params,
+		r.Header,
+	)
+}
+
+// GetPolicyReq request type for https://docs.opensearch.org/2.19/im-plugin/ism/api/#get-policy
+type GetPolicyReq struct {
+	Header   http.Header
+	PolicyID string
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r GetPolicyReq) GetRequest() (*http.Request, error) {
+	return opensearch.BuildRequest(
+		"GET",
+		fmt.Sprintf("%s/%s", policiesPath, r.PolicyID),
+		nil,
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// PutPolicyReq request type for https://docs.opensearch.org/2.19/im-plugin/ism/api/#create-policy
+// and https://docs.opensearch.org/2.19/im-plugin/ism/api/#update-policy, the existing policy is updated
+// if the sequence number and the primary term of its current version are set.
+type PutPolicyReq struct {
+	Header   http.Header
+	PolicyID string
+	// SeqNo and PrimaryTerm of the updated policy version, see PolicyDocument.
+	SeqNo       *int64
+	PrimaryTerm *int64
+	Body        json.RawMessage
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r PutPolicyReq) GetRequest() (*http.Request, error) {
+	params := make(map[string]string)
+	if r.SeqNo != nil && r.PrimaryTerm != nil {
+		params["if_seq_no"] = strconv.FormatInt(*r.SeqNo, 10)
+		params["if_primary_term"] = strconv.FormatInt(*r.PrimaryTerm, 10)
+	}
+	return opensearch.BuildRequest(
+		"PUT",
+		fmt.Sprintf("%s/%s", policiesPath, r.PolicyID),
+		bytes.NewReader(r.Body),
+		params,
+		r.Header,
+	)
+}
+
+// DeletePolicyReq request type for https://docs.opensearch.org/2.19/im-plugin/ism/api/#delete-policy
+type DeletePolicyReq struct {
+	Header   http.Header
+	PolicyID string
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r DeletePolicyReq) GetRequest() (*http.Request, error) {
+	return opensearch.BuildRequest(
+		"DELETE",
+		fmt.Sprintf("%s/%s", policiesPath, r.PolicyID),
+		nil,
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// AddPolicyReq request type for https://docs.opensearch.org/2.19/im-plugin/ism/api/#add-policy
+type AddPolicyReq struct {
+	Header  http.Header
+	Indices []string
+	Body    AddPolicyBody
+}
+
+type AddPolicyBody struct {
+	PolicyID string `json:"policy_id"`
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r AddPolicyReq) GetRequest() (*http.Request, error) {
+	body, err := json.Marshal(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return opensearch.BuildRequest(
+		"POST",
+		fmt.Sprintf("/_plugins/_ism/add/%s", strings.Join(r.Indices, ",")),
+		bytes.NewReader(body),
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// ExplainReq request type for https://docs.opensearch.org/2.19/im-plugin/ism/api/#explain-index
+type ExplainReq struct {
+	Header  http.Header
+	Indices []string
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r ExplainReq) GetRequest() (*http.Request, error) {
+	return opensearch.BuildRequest(
+		"GET",
+		fmt.Sprintf("/_plugins/_ism/explain/%s", strings.Join(r.Indices, ",")),
+		nil,
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// RetryReq request type for https://docs.opensearch.org/2.19/im-plugin/ism/api/#retry-failed-index
+type RetryReq struct {
+	Header  http.Header
+	Indices []string
+	Body    RetryBody
+}
+
+type RetryBody struct {
+	// State to retry from, the failed action is retried if it is empty.
+	State string `json:"state,omitempty"`
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r RetryReq) GetRequest() (*http.Request, error) {
+	body, err := json.Marshal(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return opensearch.BuildRequest(
+		"POST",
+		fmt.Sprintf("/_plugins/_ism/retry/%s", strings.Join(r.Indices, ",")),
+		bytes.NewReader(body),
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// PolicyBody returns the body of the put policy request from the policy definition, which is either the request
+// body, the bare policy or the get policy response, the read-only fields of the response are dropped.
+func PolicyBody(data []byte) (json.RawMessage, error) {
+	var definition map[string]json.RawMessage
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return nil, fmt.Errorf("policy is not a valid JSON object:%w", err)
+	}
+	policy, ok := definition["policy"]
+	if !ok {
+		policy = data
+	}
+	return json.Marshal(map[string]json.RawMessage{"policy": policy})
+}
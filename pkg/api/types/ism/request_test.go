@@ -0,0 +1,75 @@
+package ism
+
+import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestPolicyBody(t *testing.T) {
+	bare := `{"description":"d","default_state":"hot","states":[]}`
+	for name, data := range map[string]string{
+		"bare policy":  bare,
+		"request body": `{"policy":` + bare + `}`,
+		"get response": `{"_id":"p","_seq_no":3,"_primary_term":1,"policy":` + bare + `}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			body, err := PolicyBody([]byte(data))
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"policy":`+bare+`}`, string(body))
+		})
+	}
+	_, err := PolicyBody([]byte(`[1]`))
+	assert.ErrorContains(t, err, "not a valid JSON object")
+}
+
+func TestPutPolicyReq_GetRequest(t *testing.T) {
+	req, err := PutPolicyReq{PolicyID: "p", Body: json.RawMessage(`{}`)}.GetRequest()
+	assert.NoError(t, err)
+	assert.Equal(t, "/_plugins/_ism/policies/p", req.URL.Path)
+	assert.Empty(t, req.URL.RawQuery)
+
+	req, err = PutPolicyReq{PolicyID: "p", SeqNo: fp.AsPointer(int64(7)), PrimaryTerm: fp.AsPointer(int64(2))}.GetRequest()
+	assert.NoError(t, err)
+	assert.Equal(t, "7", req.URL.Query().Get("if_seq_no"))
+	assert.Equal(t, "2", req.URL.Query().Get("if_primary_term"))
+}
+
+func TestListPoliciesReq_GetRequest(t *testing.T) {
+	req, err := ListPoliciesReq{}.GetRequest()
+	assert.NoError(t, err)
+	assert.Equal(t, "/_plugins/_ism/policies", req.URL.Path)
+	assert.Empty(t, req.URL.RawQuery)
+
+	req, err = ListPoliciesReq{From: 1000, Size: 1000}.GetRequest()
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", req.URL.Query().Get("from"))
+	assert.Equal(t, "1000", req.URL.Query().Get("size"))
+}
+
+func TestAddPolicyReq_GetRequest(t *testing.T) {
+	req, err := AddPolicyReq{Indices: []string{"a", "b"}, Body: AddPolicyBody{PolicyID: "p"}}.GetRequest()
+	assert.NoError(t, err)
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "/_plugins/_ism/add/a,b", req.URL.Path)
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"policy_id":"p"}`, string(body))
+}
+
+func TestRetryReq_GetRequest(t *testing.T) {
+	req, err := RetryReq{Indices: []string{"a"}}.GetRequest()
+	assert.NoError(t, err)
+	assert.Equal(t, "/_plugins/_ism/retry/a", req.URL.Path)
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(body))
+
+	req, err = RetryReq{Indices: []string{"a"}, Body: RetryBody{State: "warm"}}.GetRequest()
+	assert.NoError(t, err)
+	body, err = io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"state":"warm"}`, string(body))
+}
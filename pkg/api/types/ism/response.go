@@ -0,0 +1,294 @@
+package ism
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// totalManagedKey is the key of the explain response holding the number of the managed indices, not an index.
+const totalManagedKey = "total_managed_indices"
+
+// PolicyDocument is the stored ISM policy with the version used for the optimistic concurrency control.
+type PolicyDocument struct {
+	ID          string `json:"_id"`
+	SeqNo       int64  `json:"_seq_no"`
+	PrimaryTerm int64  `json:"_primary_term"`
+	// Policy is kept as is, so the printed policy can be put back with the same content.
+	Policy json.RawMessage `json:"policy"`
+}
+
+// PolicySummary holds the fields of the policy rendered in the tables.
+type PolicySummary struct {
+	Description     string  `json:"description"`
+	DefaultState    string  `json:"default_state"`
+	LastUpdatedTime int64   `json:"last_updated_time"`
+	States          []State `json:"states"`
+}
+
+// State is the state of the policy, the actions are executed in order when the index enters the state.
+type State struct {
+	Name        string                       `json:"name"`
+	Actions     []map[string]json.RawMessage `json:"actions"`
+	Transitions []Transition                 `json:"transitions"`
+}
+
+// Transition moves the index to the next state when the conditions are met.
+type Transition struct {
+	StateName  string         `json:"state_name"`
+	Conditions map[string]any `json:"conditions"`
+}
+
+// actionOptions are the keys of the action object which configure the action and don't name it.
+var actionOptions = []string{"retry", "timeout", "custom_action"}
+
+// ActionNames returns the names of the state actions in the execution order.
+func (s State) ActionNames() []string {
+	names := make([]string, 0, len(s.Actions))
+	for _, action := range s.Actions {
+		for _, key := range slices.Sorted(maps.Keys(action)) {
+			if !slices.Contains(actionOptions, key) {
+				names = append(names, key)
+			}
+		}
+	}
+	return names
+}
+
+// String returns the target state followed by the conditions, e.g. warm(min_index_age=7d).
+func (t Transition) String() string {
+	if len(t.Conditions) == 0 {
+		return t.StateName
+	}
+	conditions := gu.FlattenValues(t.Conditions)
+	pairs := make([]string, 0, len(conditions))
+	for _, key := range slices.Sorted(maps.Keys(conditions)) {
+		pairs = append(pairs, key+"="+conditions[key])
+	}
+	return fmt.Sprintf("%s(%s)", t.StateName, strings.Join(pairs, ", "))
+}
+
+// Summary parses the rendered fields of the policy, the document comes from the cluster so the fields
+// which can't be parsed are left empty.
+func (d PolicyDocument) Summary() PolicySummary {
+	var summary PolicySummary
+	_ = json.Unmarshal(d.Policy, &summary)
+	return summary
+}
+
+// TableHeader returns the column names of the policy table.
+func (d PolicyDocument) TableHeader(_ bool) []string {
+	return []string{"state", "actions", "transitions"}
+}
+
+// TableRows returns a row per policy state, the default state is marked with '*'.
+func (d PolicyDocument) TableRows(_ bool) [][]string {
+	summary := d.Summary()
+	rows := make([][]string, 0, len(summary.States))
+	for _, state := range summary.States {
+		name := state.Name
+		if name == summary.DefaultState {
+			name += "*"
+		}
+		transitions := make([]string, 0, len(state.Transitions))
+		for _, transition := range state.Transitions {
+			transitions = append(transitions, transition.String())
+		}
+		rows = append(rows, []string{name, strings.Join(state.ActionNames(), " -> "), strings.Join(transitions, ", ")})
+	}
+	return rows
+}
+
+// Policies is the list of the ISM policies.
+type Policies []PolicyDocument
+
+// TableHeader returns the column names of the policies table.
+func (p Policies) TableHeader(_ bool) []string {
+	return []string{"policy", "description", "default state", "states", "updated"}
+}
+
+// TableRows returns a row per policy.
+func (p Policies) TableRows(_ bool) [][]string {
+	rows := make([][]string, 0, len(p))
+	for _, document := range p {
+		summary := document.Summary()
+		states := make([]string, 0, len(summary.States))
+		for _, state := range summary.States {
+			states = append(states, state.Name)
+		}
+		updated := ""
+		if summary.LastUpdatedTime > 0 {
+			updated = time.UnixMilli(summary.LastUpdatedTime).UTC().Format(time.RFC3339)
+		}
+		rows = append(rows, []string{document.ID, summary.Description, summary.DefaultState, strings.Join(states, ", "), updated})
+	}
+	return rows
+}
+
+// ExplainedIndex is the ISM state of the index, PolicyID is empty if the index is not managed.
+type ExplainedIndex struct {
+	Index     string         `json:"index"`
+	PolicyID  string         `json:"policy_id,omitempty"`
+	Enabled   *bool          `json:"enabled,omitempty"`
+	State     *StateInfo     `json:"state,omitempty"`
+	Action    *ActionInfo    `json:"action,omitempty"`
+	Step      *StepInfo      `json:"step,omitempty"`
+	RetryInfo *RetryInfo     `json:"retry_info,omitempty"`
+	Info      map[string]any `json:"info,omitempty"`
+}
+
+type StateInfo struct {
+	Name      string `json:"name"`
+	StartTime int64  `json:"start_time"`
+}
+
+type ActionInfo struct {
+	Name            string `json:"name"`
+	StartTime       int64  `json:"start_time"`
+	Failed          bool   `json:"failed"`
+	ConsumedRetries int    `json:"consumed_retries"`
+}
+
+type StepInfo struct {
+	Name       string `json:"name"`
+	StartTime  int64  `json:"start_time"`
+	StepStatus string `json:"step_status"`
+}
+
+type RetryInfo struct {
+	Failed          bool `json:"failed"`
+	ConsumedRetries int  `json:"consumed_retries"`
+}
+
+// Managed returns true if the policy is attached to the index.
+func (e ExplainedIndex) Managed() bool {
+	return e.PolicyID != ""
+}
+
+// Failed returns true if the managed index is stuck on the failed action and waits for the retry.
+func (e ExplainedIndex) Failed() bool {
+	return (e.Action != nil && e.Action.Failed) ||
+		(e.RetryInfo != nil && e.RetryInfo.Failed) ||
+		(e.Step != nil && e.Step.StepStatus == "failed")
+}
+
+// Message returns the info message of the index followed by the failure cause if any.
+func (e ExplainedIndex) Message() string {
+	var parts []string
+	for _, key := range []string{"message", "cause"} {
+		if value, ok := e.Info[key]; ok && value != nil {
+			parts = append(parts, fmt.Sprint(value))
+		}
+	}
+	return strings.Join(parts, ": ")
+}
+
+// Explanations is the ISM state of the indices sorted by the index name.
+type Explanations []ExplainedIndex
+
+// UnmarshalJSON decodes the explain response keyed by the index name.
+func (e *Explanations) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	result := make(Explanations, 0, len(raw))
+	for _, name := range slices.Sorted(maps.Keys(raw)) {
+		if name == totalManagedKey {
+			continue
+		}
+		var explained ExplainedIndex
+		if err := json.Unmarshal(raw[name], &explained); err != nil {
+			return fmt.Errorf("failed to decode explanation of index '%s':%w", name, err)
+		}
+		explained.Index = name
+		result = append(result, explained)
+	}
+	*e = result
+	return nil
+}
+
+// TableHeader returns the column names of the explanations table.
+func (e Explanations) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"index", "policy", "state", "action", "step", "status", "retries", "info"}
+	}
+	return []string{"index", "policy", "state", "action", "status", "info"}
+}
+
+// TableRows returns a row per index.
+func (e Explanations) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(e))
+	for _, explained := range e {
+		if !explained.Managed() {
+			row := []string{explained.Index, "", "", "", "➖not managed", ""}
+			if wide {
+				row = []string{explained.Index, "", "", "", "", "➖not managed", "", ""}
+			}
+			rows = append(rows, row)
+			continue
+		}
+		var state, action, step, status, retries string
+		if explained.State != nil {
+			state = explained.State.Name
+		}
+		if explained.Action != nil {
+			action = explained.Action.Name
+			retries = fmt.Sprint(explained.Action.ConsumedRetries)
+		}
+		if explained.Step != nil {
+			step = explained.Step.Name
+			status = explained.Step.StepStatus
+		}
+		switch {
+		case explained.Failed():
+			status = "❌failed"
+		case explained.Enabled != nil && !*explained.Enabled:
+			status = "⚠️disabled"
+		case status == "":
+			status = "⏳initializing"
+		}
+		message := strings.Join(strings.Fields(explained.Message()), " ")
+		if wide {
+			rows = append(rows, []string{explained.Index, explained.PolicyID, state, action, step, status, retries, message})
+		} else {
+			rows = append(rows, []string{explained.Index, explained.PolicyID, state, action, status, message})
+		}
+	}
+	return rows
+}
+
+// ChangeResult is the response of the add policy and the retry requests.
+type ChangeResult struct {
+	UpdatedIndices int           `json:"updated_indices"`
+	Failures       bool          `json:"failures"`
+	FailedIndices  []FailedIndex `json:"failed_indices"`
+}
+
+type FailedIndex struct {
+	IndexName string `json:"index_name"`
+	IndexUUID string `json:"index_uuid"`
+	Reason    string `json:"reason"`
+}
+
+// Results returns the result of the operation per requested index, the index is done unless it is reported as failed.
+func (r ChangeResult) Results(operation string, indexNames []string) indices.OperationResults {
+	reasons := make(map[string]string, len(r.FailedIndices))
+	for _, failed := range r.FailedIndices {
+		reasons[failed.IndexName] = failed.Reason
+	}
+	results := make(indices.OperationResults, 0, len(indexNames))
+	for _, index := range indexNames {
+		if reason, failed := reasons[index]; failed {
+			results = append(results, indices.OperationResult{Index: index, Operation: operation, Status: indices.ResultFailed, Details: reason})
+		} else {
+			results = append(results, indices.OperationResult{Index: index, Operation: operation, Status: indices.ResultDone})
+		}
+	}
+	return results
+}
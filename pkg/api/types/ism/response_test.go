@@ -0,0 +1,86 @@
+package ism
+
+import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const policyResponse = `{
+  "_id": "hot-delete", "_seq_no": 4, "_primary_term": 1,
+  "policy": {
+    "policy_id": "hot-delete",
+    "description": "hot then delete",
+    "last_updated_time": 1717200000000,
+    "default_state": "hot",
+    "states": [
+      {"name": "hot", "actions": [{"retry": {"count": 3}, "rollover": {"min_size": "50gb"}}, {"replica_count": {"number_of_replicas": 1}}],
+       "transitions": [{"state_name": "delete", "conditions": {"min_index_age": "30d"}}]},
+      {"name": "delete", "actions": [{"delete": {}}], "transitions": []}
+    ]
+  }
+}`
+
+func TestPolicyDocument_TableRows(t *testing.T) {
+	var document PolicyDocument
+	assert.NoError(t, json.Unmarshal([]byte(policyResponse), &document))
+	assert.Equal(t, int64(4), document.SeqNo)
+	assert.Equal(t, [][]string{
+		{"hot*", "rollover -> replica_count", "delete(min_index_age=30d)"},
+		{"delete", "delete", ""},
+	}, document.TableRows(false))
+	assert.Equal(t, [][]string{
+		{"hot-delete", "hot then delete", "hot", "hot, delete", "2024-06-01T00:00:00Z"},
+	}, Policies{document}.TableRows(false))
+}
+
+const explainResponse = `{
+  "logs-1": {
+    "index.plugins.index_state_management.policy_id": "hot-delete",
+    "index": "logs-1", "policy_id": "hot-delete", "enabled": true,
+    "state": {"name": "hot", "start_time": 1717200000000},
+    "action": {"name": "rollover", "start_time": 1717200000000, "index": 0, "failed": true, "consumed_retries": 3},
+    "step": {"name": "attempt_rollover", "start_time": 1717200000000, "step_status": "failed"},
+    "retry_info": {"failed": true, "consumed_retries": 3},
+    "info": {"message": "Missing rollover_alias", "cause": "index.plugins.index_state_management.rollover_alias\nis not set"}
+  },
+  "logs-2": {
+    "index": "logs-2", "policy_id": "hot-delete", "enabled": true,
+    "state": {"name": "hot", "start_time": 1717200000000},
+    "action": {"name": "transition", "failed": false, "consumed_retries": 0},
+    "step": {"name": "attempt_transition_step", "step_status": "condition_not_met"},
+    "retry_info": {"failed": false, "consumed_retries": 0},
+    "info": {"message": "Evaluating transition conditions [index=logs-2]"}
+  },
+  "plain": {"index.plugins.index_state_management.policy_id": null, "enabled": null},
+  "total_managed_indices": 2
+}`
+
+func TestExplanations(t *testing.T) {
+	var explanations Explanations
+	assert.NoError(t, json.Unmarshal([]byte(explainResponse), &explanations))
+	assert.Len(t, explanations, 3)
+	assert.True(t, explanations[0].Failed())
+	assert.False(t, explanations[1].Failed())
+	assert.False(t, explanations[2].Managed())
+	assert.Equal(t, [][]string{
+		{"logs-1", "hot-delete", "hot", "rollover", "❌failed",
+			"Missing rollover_alias: index.plugins.index_state_management.rollover_alias is not set"},
+		{"logs-2", "hot-delete", "hot", "transition", "condition_not_met", "Evaluating transition conditions [index=logs-2]"},
+		{"plain", "", "", "", "➖not managed", ""},
+	}, explanations.TableRows(false))
+	assert.Equal(t, []string{"plain", "", "", "", "", "➖not managed", "", ""}, explanations.TableRows(true)[2])
+}
+
+func TestChangeResult_Results(t *testing.T) {
+	var result ChangeResult
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"updated_indices": 1, "failures": true,
+		"failed_indices": [{"index_name": "b", "index_uuid": "u", "reason": "This index already has a policy"}]
+	}`), &result))
+	assert.Equal(t, indices.OperationResults{
+		{Index: "a", Operation: "attach", Status: indices.ResultDone},
+		{Index: "b", Operation: "attach", Status: indices.ResultFailed, Details: "This index already has a policy"},
+	}, result.Results("attach", []string{"a", "b"}))
+}
@@ -0,0 +1,123 @@
+package reindex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SlicesAuto lets the cluster choose the number of slices.
+const SlicesAuto = "auto"
+
+// Remote is the remote cluster the documents are reindexed from.
+type Remote struct {
+	// Host is the HTTP address of the remote cluster, e.g. https://leader:9200.
+	Host     string `json:"host"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// Options holds the parameters of the reindex request.
+type Options struct {
+	// Source is the list of the source indices or patterns.
+	Source []string
+	Dest   string
+	// QueryString filters the source documents with the query string syntax, it can't be set together with Query.
+	QueryString string
+	// Query filters the source documents with the Query DSL.
+	Query json.RawMessage
+	// MaxDocs limits the number of reindexed documents, 0 means all.
+	MaxDocs int
+	// Slices is the number of the parallel slices or 'auto', empty means the single slice.
+	Slices string
+	// RequestsPerSecond throttles the reindex, nil keeps the cluster default(unthrottled).
+	RequestsPerSecond *int
+	// Remote is set when the documents are reindexed from the remote cluster.
+	Remote *Remote
+}
+
+// Validate checks the options are consistent.
+func (o Options) Validate() error {
+	if len(o.Source) == 0 {
+		return errors.New("source index is required")
+	} else if o.Dest == "" {
+		return errors.New("destination index is required")
+	} else if o.QueryString != "" && len(o.Query) > 0 {
+		return errors.New("query string and Query DSL are mutually exclusive")
+	} else if len(o.Query) > 0 && !json.Valid(o.Query) {
+		return errors.New("query is not a valid JSON")
+	} else if o.MaxDocs < 0 {
+		return errors.New("max docs can't be negative")
+	} else if o.Remote != nil && o.Slices != "" {
+		return errors.New("slices can't be used with the remote source")
+	} else if o.RequestsPerSecond != nil {
+		if err := ValidateRequestsPerSecond(*o.RequestsPerSecond); err != nil {
+			return err
+		}
+	}
+	_, err := o.SlicesParam()
+	return err
+}
+
+// ValidateRequestsPerSecond checks the throttling of the reindex is the positive number or -1 for no throttling.
+func ValidateRequestsPerSecond(requestsPerSecond int) error {
+	if requestsPerSecond == 0 || requestsPerSecond < -1 {
+		return fmt.Errorf("requests per second must be the positive number or -1 for no throttling, got %d", requestsPerSecond)
+	}
+	return nil
+}
+
+// SlicesParam returns the slices parameter of the request, nil if it is not set.
+func (o Options) SlicesParam() (any, error) {
+	if o.Slices == "" {
+		return nil, nil
+	} else if o.Slices == SlicesAuto {
+		return SlicesAuto, nil
+	}
+	slices, err := strconv.Atoi(o.Slices)
+	if err != nil || slices < 1 {
+		return nil, fmt.Errorf("slices must be '%s' or the positive number, got '%s'", SlicesAuto, o.Slices)
+	}
+	return slices, nil
+}
+
+// Body returns the body of the reindex request.
+func (o Options) Body() ([]byte, error) {
+	source := map[string]any{"index": o.Source}
+	if o.QueryString != "" {
+		source["query"] = map[string]any{"query_string": map[string]any{"query": o.QueryString}}
+	} else if len(o.Query) > 0 {
+		source["query"] = o.Query
+	}
+	if o.Remote != nil {
+		source["remote"] = o.Remote
+	}
+	body := map[string]any{
+		"source": source,
+		"dest":   map[string]any{"index": o.Dest},
+	}
+	if o.MaxDocs > 0 {
+		body["max_docs"] = o.MaxDocs
+	}
+	return json.Marshal(body)
+}
+
+// RemoteHost returns the HTTP address of the remote cluster from the transport address of the remote
+// configured for the cross-cluster replication, the transport port is replaced by the HTTP port.
+func RemoteHost(address, scheme string, port int) (string, error) {
+	if address == "" {
+		return "", errors.New("remote address is empty")
+	}
+	if parsed, err := url.Parse(address); err == nil && parsed.Host != "" {
+		address = parsed.Host
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = strings.Trim(address, "[]")
+	}
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, strconv.Itoa(port))), nil
+}
@@ -0,0 +1,97 @@
+package reindex
+
+import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOptions_Body(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "plain",
+			opts:     Options{Source: []string{"a", "b-*"}, Dest: "c"},
+			expected: `{"source":{"index":["a","b-*"]},"dest":{"index":"c"}}`,
+		},
+		{
+			name:     "query string and max docs",
+			opts:     Options{Source: []string{"a"}, Dest: "c", QueryString: "status:failed", MaxDocs: 10},
+			expected: `{"source":{"index":["a"],"query":{"query_string":{"query":"status:failed"}}},"dest":{"index":"c"},"max_docs":10}`,
+		},
+		{
+			name: "query DSL from remote",
+			opts: Options{
+				Source: []string{"a"},
+				Dest:   "c",
+				Query:  json.RawMessage(`{"term":{"level":"ERROR"}}`),
+				Remote: &Remote{Host: "https://leader:9200", Username: "admin", Password: "secret"},
+			},
+			expected: `{"source":{"index":["a"],"query":{"term":{"level":"ERROR"}},` +
+				`"remote":{"host":"https://leader:9200","username":"admin","password":"secret"}},"dest":{"index":"c"}}`,
+		},
+		{name: "missing source", opts: Options{Dest: "c"}, wantErr: true},
+		{name: "missing dest", opts: Options{Source: []string{"a"}}, wantErr: true},
+		{name: "both queries", opts: Options{Source: []string{"a"}, Dest: "c", QueryString: "x", Query: json.RawMessage(`{}`)}, wantErr: true},
+		{name: "invalid query DSL", opts: Options{Source: []string{"a"}, Dest: "c", Query: json.RawMessage(`{`)}, wantErr: true},
+		{name: "invalid slices", opts: Options{Source: []string{"a"}, Dest: "c", Slices: "0"}, wantErr: true},
+		{name: "slices from remote", opts: Options{Source: []string{"a"}, Dest: "c", Slices: "2", Remote: &Remote{Host: "https://leader:9200"}}, wantErr: true},
+		{name: "zero requests per second", opts: Options{Source: []string{"a"}, Dest: "c", RequestsPerSecond: fp.AsPointer(0)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); tt.wantErr {
+				assert.Error(t, err)
+				return
+			} else {
+				assert.NoError(t, err)
+			}
+			body, err := tt.opts.Body()
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(body))
+		})
+	}
+}
+
+func TestOptions_SlicesParam(t *testing.T) {
+	for slices, expected := range map[string]any{"": nil, "auto": "auto", "4": 4} {
+		param, err := Options{Slices: slices}.SlicesParam()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, param)
+	}
+	_, err := Options{Slices: "many"}.SlicesParam()
+	assert.Error(t, err)
+}
+
+func TestValidateRequestsPerSecond(t *testing.T) {
+	assert.NoError(t, ValidateRequestsPerSecond(500))
+	assert.NoError(t, ValidateRequestsPerSecond(-1))
+	assert.Error(t, ValidateRequestsPerSecond(0))
+	assert.Error(t, ValidateRequestsPerSecond(-2))
+}
+
+func TestRemoteHost(t *testing.T) {
+	tests := []struct {
+		address  string
+		expected string
+	}{
+		{address: "leader:9300", expected: "https://leader:9200"},
+		{address: "10.0.0.1", expected: "https://10.0.0.1:9200"},
+		{address: "[::1]:9300", expected: "https://[::1]:9200"},
+		{address: "tcp://leader.example.com:9300", expected: "https://leader.example.com:9200"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			host, err := RemoteHost(tt.address, "https", 9200)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, host)
+		})
+	}
+	_, err := RemoteHost("", "https", 9200)
+	assert.Error(t, err)
+}
@@ -0,0 +1,78 @@
+package reindex
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Status is the progress of the running reindex task, and the summary of the completed one.
+type Status struct {
+	Total             int64             `json:"total"`
+	Created           int64             `json:"created"`
+	Updated           int64             `json:"updated"`
+	Deleted           int64             `json:"deleted"`
+	Batches           int64             `json:"batches"`
+	VersionConflicts  int64             `json:"version_conflicts"`
+	Noops             int64             `json:"noops"`
+	ThrottledMillis   int64             `json:"throttled_millis"`
+	RequestsPerSecond float64           `json:"requests_per_second"`
+	Took              int64             `json:"took,omitempty"`
+	TimedOut          bool              `json:"timed_out,omitempty"`
+	Failures          []json.RawMessage `json:"failures,omitempty"`
+}
+
+// Processed returns the number of the source documents processed so far.
+func (s Status) Processed() int64 {
+	return s.Created + s.Updated + s.Deleted + s.VersionConflicts + s.Noops
+}
+
+// Throughput returns the processing rate in documents per second and the estimated time left,
+// the estimation is 0 if the rate or the total is not known yet.
+func (s Status) Throughput(running time.Duration) (float64, time.Duration) {
+	if running <= 0 {
+		return 0, 0
+	}
+	rate := float64(s.Processed()) / running.Seconds()
+	if rate == 0 || s.Total == 0 {
+		return rate, 0
+	}
+	left := float64(max(s.Total-s.Processed(), 0)) / rate
+	return rate, time.Duration(left * float64(time.Second)).Round(time.Second)
+}
+
+// Details returns the progress details shown next to the progress bar.
+func (s Status) Details(running time.Duration) string {
+	rate, eta := s.Throughput(running)
+	details := fmt.Sprintf("%.0f docs/s", rate)
+	if eta > 0 {
+		details += fmt.Sprintf(", ETA %s", eta)
+	}
+	if s.VersionConflicts > 0 {
+		details += fmt.Sprintf(", %d conflicts", s.VersionConflicts)
+	}
+	return details
+}
+
+// TableHeader returns the column names of the reindex summary table.
+func (s Status) TableHeader(_ bool) []string {
+	return []string{"property", "value"}
+}
+
+// TableRows returns the counters of the reindex.
+func (s Status) TableRows(_ bool) [][]string {
+	return [][]string{
+		{"total", strconv.FormatInt(s.Total, 10)},
+		{"created", strconv.FormatInt(s.Created, 10)},
+		{"updated", strconv.FormatInt(s.Updated, 10)},
+		{"deleted", strconv.FormatInt(s.Deleted, 10)},
+		{"version_conflicts", strconv.FormatInt(s.VersionConflicts, 10)},
+		{"noops", strconv.FormatInt(s.Noops, 10)},
+		{"batches", strconv.FormatInt(s.Batches, 10)},
+		{"throttled", (time.Duration(s.ThrottledMillis) * time.Millisecond).String()},
+		{"took", (time.Duration(s.Took) * time.Millisecond).String()},
+		{"timed_out", strconv.FormatBool(s.TimedOut)},
+		{"failures", strconv.Itoa(len(s.Failures))},
+	}
+}
@@ -0,0 +1,21 @@
+package reindex
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStatus_Throughput(t *testing.T) {
+	status := Status{Total: 1000, Created: 150, Updated: 40, VersionConflicts: 10}
+	rate, eta := status.Throughput(10 * time.Second)
+	assert.Equal(t, int64(200), status.Processed())
+	assert.Equal(t, 20.0, rate)
+	assert.Equal(t, 40*time.Second, eta)
+	assert.Equal(t, "20 docs/s, ETA 40s, 10 conflicts", status.Details(10*time.Second))
+
+	rate, eta = Status{}.Throughput(0)
+	assert.Zero(t, rate)
+	assert.Zero(t, eta)
+	assert.Equal(t, "0 docs/s", Status{Total: 10}.Details(time.Second))
+}
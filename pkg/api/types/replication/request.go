@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/opensearch-project/opensearch-go/v4"
 	"net/http"
+	"regexp"
 )
 
 // StartReplicationReq request type for https://docs.opensearch.org/2.19/tuning-your-cluster/replication-plugin/api/#start-replication
@@ -153,3 +154,55 @@ func (r DeleteAutofollowReq) GetRequest() (*http.Request, error) {
 		r.Header,
 	)
 }
+
+// RenameTemplate derives the follower index names from the leader index names replicated in bulk.
+// The regular expression replacement is applied first, then the prefix and the suffix are added.
+type RenameTemplate struct {
+	Prefix string
+	Suffix string
+	// Pattern is the optional regular expression replaced in the leader index name with Replacement,
+	// which can reference the groups of the pattern, e.g. '${1}'.
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+// NewRenameTemplate returns the template with the compiled pattern, the empty pattern keeps the leader index name.
+func NewRenameTemplate(prefix, suffix, pattern, replacement string) (RenameTemplate, error) {
+	template := RenameTemplate{Prefix: prefix, Suffix: suffix, Replacement: replacement}
+	if pattern != "" {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return template, fmt.Errorf("invalid rename pattern '%s':%w", pattern, err)
+		}
+		template.Pattern = compiled
+	}
+	return template, nil
+}
+
+// FollowerIndex returns the name of the follower index of the leader index.
+func (t RenameTemplate) FollowerIndex(leaderIndex string) string {
+	name := leaderIndex
+	if t.Pattern != nil {
+		name = t.Pattern.ReplaceAllString(name, t.Replacement)
+	}
+	return t.Prefix + name + t.Suffix
+}
+
+// FollowerIndices maps the leader indices to the follower index names,
+// returns the error if the name is empty or the same follower name is derived for several leader indices.
+func (t RenameTemplate) FollowerIndices(leaderIndices []string) (map[string]string, error) {
+	followers := make(map[string]string, len(leaderIndices))
+	leaders := make(map[string]string, len(leaderIndices))
+	for _, leaderIndex := range leaderIndices {
+		follower := t.FollowerIndex(leaderIndex)
+		if follower == "" {
+			return nil, fmt.Errorf("empty follower index name for leader index '%s'", leaderIndex)
+		}
+		if other, found := leaders[follower]; found {
+			return nil, fmt.Errorf("leader indices '%s' and '%s' have the same follower index '%s'", other, leaderIndex, follower)
+		}
+		leaders[follower] = leaderIndex
+		followers[leaderIndex] = follower
+	}
+	return followers, nil
+}
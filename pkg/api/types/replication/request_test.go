@@ -0,0 +1,27 @@
+package replication
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRenameTemplate_FollowerIndices(t *testing.T) {
+	template, err := NewRenameTemplate("dr-", "", "", "")
+	assert.NoError(t, err)
+	followers, err := template.FollowerIndices([]string{"logs-1", "logs-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"logs-1": "dr-logs-1", "logs-2": "dr-logs-2"}, followers)
+
+	template, err = NewRenameTemplate("", "-replica", `^logs-(\d+)$`, "archive-${1}")
+	assert.NoError(t, err)
+	assert.Equal(t, "archive-7-replica", template.FollowerIndex("logs-7"))
+	assert.Equal(t, "orders-replica", template.FollowerIndex("orders"))
+
+	template, err = NewRenameTemplate("", "", `-\d+$`, "")
+	assert.NoError(t, err)
+	_, err = template.FollowerIndices([]string{"logs-1", "logs-2"})
+	assert.EqualError(t, err, "leader indices 'logs-1' and 'logs-2' have the same follower index 'logs'")
+
+	_, err = NewRenameTemplate("", "", "(", "")
+	assert.ErrorContains(t, err, "invalid rename pattern '('")
+}
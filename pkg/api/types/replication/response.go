@@ -1 +1,46 @@
 package replication
+
+import (
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"strconv"
+)
+
+// AcknowledgedResponse represents the response of the replication plugin to the state changing requests.
+type AcknowledgedResponse struct {
+	Acknowledged bool `json:"acknowledged"`
+}
+
+// TableHeader returns the column names of the acknowledged response table.
+func (r AcknowledgedResponse) TableHeader(bool) []string {
+	return []string{"acknowledged"}
+}
+
+// TableRows returns the single row of the acknowledged response table.
+func (r AcknowledgedResponse) TableRows(bool) [][]string {
+	return [][]string{{strconv.FormatBool(r.Acknowledged)}}
+}
+
+// RecoveryStatusResponse represents the recovery(replication task) status of the index shards.
+type RecoveryStatusResponse []opensearchapi.CatRecoveryItemResp
+
+// TableHeader returns the column names of the recovery status table.
+func (l RecoveryStatusResponse) TableHeader(wide bool) []string {
+	header := []string{"index", "shard", "type", "stage", "source node", "target node", "bytes percent", "time"}
+	if wide {
+		header = append(header, "files percent", "translog ops percent", "source host", "target host", "repository", "snapshot")
+	}
+	return header
+}
+
+// TableRows returns a row per shard recovery.
+func (l RecoveryStatusResponse) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(l))
+	for _, r := range l {
+		row := []string{r.Index, strconv.Itoa(r.Shard), r.Type, r.Stage, r.SourceNode, r.TargetNode, r.BytesPercent, r.Time}
+		if wide {
+			row = append(row, r.FilesPercent, r.TranslogOpsPercent, r.SourceHost, r.TargetHost, r.Repository, r.Snapshot)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
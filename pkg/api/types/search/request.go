@@ -0,0 +1,112 @@
+package search
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+)
+
+const (
+	// PaginateScroll fetches the pages with the scroll API(default).
+	PaginateScroll = "scroll"
+	// PaginatePIT fetches the pages with search_after on the point in time,
+	// the sort is required and its last field must be unique to not skip the hits.
+	PaginatePIT = "pit"
+
+	// DefaultPageSize is the number of hits fetched by the single page request.
+	DefaultPageSize = 1000
+	// DefaultKeepAlive is the time the scroll or the point in time is kept between the page requests.
+	DefaultKeepAlive = time.Minute
+)
+
+// PaginateModes holds the list of supported pagination modes.
+var PaginateModes = []string{PaginateScroll, PaginatePIT}
+
+// Options holds the parameters of the search.
+type Options struct {
+	// Query is the Lucene query string, it replaces the query of the Body.
+	Query string
+	// Body is the Query DSL request body.
+	Body map[string]any
+	// Fields limits the returned source fields, wildcards are supported.
+	Fields []string
+	// Sort holds the sort fields in the form field[:asc|desc], it replaces the sort of the Body.
+	Sort []string
+	// Size is the maximum number of the returned hits, negative means all of them.
+	Size int
+	// PageSize is the number of hits fetched by the single request when paginating, DefaultPageSize if not set.
+	PageSize int
+	// Paginate selects the pagination mode, one of PaginateModes, PaginateScroll if not set.
+	Paginate string
+}
+
+// PageSizeOrDefault returns the page size of the options or DefaultPageSize if not set.
+func (o Options) PageSizeOrDefault() int {
+	if o.PageSize > 0 {
+		return o.PageSize
+	}
+	return DefaultPageSize
+}
+
+// NeedsPagination returns true if the hits don't fit in the single page.
+func (o Options) NeedsPagination() bool {
+	return o.Size < 0 || o.Size > o.PageSizeOrDefault()
+}
+
+// BodySize returns the size set in the Body, false if the Body has no valid size.
+func (o Options) BodySize() (int, bool) {
+	switch size := o.Body["size"].(type) {
+	case float64:
+		if size >= 0 && size == float64(int(size)) {
+			return int(size), true
+		}
+	case int:
+		if size >= 0 {
+			return size, true
+		}
+	}
+	return 0, false
+}
+
+// BuildBody returns the request body built from the Body with the query, fields and sort applied, size is the page size
+// and replaces the size of the Body, use BodySize to keep the size of the Body as the Size of the options.
+func (o Options) BuildBody(size int) (map[string]any, error) {
+	body := map[string]any{}
+	maps.Copy(body, o.Body)
+	if o.Query != "" {
+		body["query"] = map[string]any{"query_string": map[string]any{"query": o.Query}}
+	}
+	if len(o.Fields) > 0 {
+		body["_source"] = o.Fields
+	}
+	if len(o.Sort) > 0 {
+		sort, err := ParseSort(o.Sort)
+		if err != nil {
+			return nil, err
+		}
+		body["sort"] = sort
+	}
+	body["size"] = size
+	return body, nil
+}
+
+// ParseSort converts the field[:asc|desc] list to the Query DSL sort.
+func ParseSort(fields []string) ([]any, error) {
+	sort := make([]any, 0, len(fields))
+	for _, f := range fields {
+		field, order, hasOrder := strings.Cut(f, ":")
+		if field == "" {
+			return nil, fmt.Errorf("sort field is empty in '%s'", f)
+		}
+		if !hasOrder {
+			sort = append(sort, field)
+			continue
+		}
+		if order != "asc" && order != "desc" {
+			return nil, fmt.Errorf("sort order of '%s' must be asc or desc", field)
+		}
+		sort = append(sort, map[string]any{field: map[string]any{"order": order}})
+	}
+	return sort, nil
+}
@@ -0,0 +1,112 @@
+package search
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOptions_BuildBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		expected map[string]any
+		wantErr  bool
+	}{
+		{
+			name:     "empty options",
+			opts:     Options{},
+			expected: map[string]any{"size": 10},
+		},
+		{
+			name: "query string, fields and sort override the body",
+			opts: Options{
+				Query:  "level:ERROR",
+				Body:   map[string]any{"query": map[string]any{"match_all": map[string]any{}}, "sort": []any{"x"}, "aggs": "kept"},
+				Fields: []string{"message", "@timestamp"},
+				Sort:   []string{"@timestamp:desc", "id"},
+			},
+			expected: map[string]any{
+				"query":   map[string]any{"query_string": map[string]any{"query": "level:ERROR"}},
+				"_source": []string{"message", "@timestamp"},
+				"sort":    []any{map[string]any{"@timestamp": map[string]any{"order": "desc"}}, "id"},
+				"aggs":    "kept",
+				"size":    10,
+			},
+		},
+		{
+			name:    "invalid sort order",
+			opts:    Options{Sort: []string{"id:up"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := tt.opts.BuildBody(10)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, body)
+		})
+	}
+}
+
+func TestOptions_BodySize(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     map[string]any
+		expected int
+		found    bool
+	}{
+		{name: "no body", body: nil},
+		{name: "body without size", body: map[string]any{"query": "q"}},
+		{name: "decoded body size", body: map[string]any{"size": float64(50)}, expected: 50, found: true},
+		{name: "zero body size", body: map[string]any{"size": float64(0)}, expected: 0, found: true},
+		{name: "fractional body size", body: map[string]any{"size": 1.5}},
+		{name: "negative body size", body: map[string]any{"size": float64(-1)}},
+		{name: "string body size", body: map[string]any{"size": "50"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, found := Options{Body: tt.body}.BodySize()
+			assert.Equal(t, tt.expected, size)
+			assert.Equal(t, tt.found, found)
+		})
+	}
+}
+
+func TestOptions_BuildBodyWithBodySize(t *testing.T) {
+	opts := Options{Body: map[string]any{"size": float64(50)}, Size: 10}
+	opts.Size, _ = opts.BodySize()
+	body, err := opts.BuildBody(opts.Size)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"size": 50}, body, "size of the body is kept")
+	assert.False(t, opts.NeedsPagination())
+}
+
+func TestOptions_NeedsPagination(t *testing.T) {
+	assert.False(t, Options{Size: 10}.NeedsPagination())
+	assert.False(t, Options{Size: DefaultPageSize}.NeedsPagination())
+	assert.True(t, Options{Size: DefaultPageSize + 1}.NeedsPagination())
+	assert.True(t, Options{Size: 20, PageSize: 10}.NeedsPagination())
+	assert.True(t, Options{Size: -1}.NeedsPagination())
+}
+
+func TestFieldValue(t *testing.T) {
+	source := map[string]any{
+		"message":  "hello",
+		"count":    float64(3),
+		"user":     map[string]any{"name": "bob", "roles": []any{"a", "b"}},
+		"host.ip":  "10.0.0.1",
+		"disabled": false,
+	}
+	assert.Equal(t, "hello", FieldValue(source, "message"))
+	assert.Equal(t, "3", FieldValue(source, "count"))
+	assert.Equal(t, "bob", FieldValue(source, "user.name"))
+	assert.Equal(t, `["a","b"]`, FieldValue(source, "user.roles"))
+	assert.Equal(t, "10.0.0.1", FieldValue(source, "host.ip"))
+	assert.Equal(t, "false", FieldValue(source, "disabled"))
+	assert.Equal(t, "", FieldValue(source, "missing.field"))
+	assert.Equal(t, "", FieldValue(source, "message.nested"))
+}
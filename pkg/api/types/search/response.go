@@ -0,0 +1,119 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Hit represents the single search hit.
+type Hit struct {
+	Index   string          `json:"_index"`
+	ID      string          `json:"_id"`
+	Routing string          `json:"_routing,omitempty"`
+	Score   *float64        `json:"_score,omitempty"`
+	Source  json.RawMessage `json:"_source,omitempty"`
+	Sort    []any           `json:"sort,omitempty"`
+}
+
+// Response represents the part of the search and scroll responses used by the search.
+type Response struct {
+	ScrollID string `json:"_scroll_id,omitempty"`
+	PitID    string `json:"pit_id,omitempty"`
+	Hits     struct {
+		Total struct {
+			Value    int    `json:"value"`
+			Relation string `json:"relation"`
+		} `json:"total"`
+		Hits []Hit `json:"hits"`
+	} `json:"hits"`
+}
+
+// Summary holds the totals of the search.
+type Summary struct {
+	// Total is the number of the matched documents, it is the lower bound if Relation is 'gte'.
+	Total    int
+	Relation string
+	// Returned is the number of the hits passed to the handler.
+	Returned int
+}
+
+// String returns the human-readable summary.
+func (s Summary) String() string {
+	total := fmt.Sprintf("%d", s.Total)
+	if s.Relation == "gte" {
+		total = ">=" + total
+	}
+	return fmt.Sprintf("%d of %s hits", s.Returned, total)
+}
+
+// HitsTable represents the hits as a table, the fields are shown as columns or the whole source if no fields are set.
+type HitsTable struct {
+	Fields []string
+	Hits   []Hit
+}
+
+// TableHeader returns the column names of the hits table.
+func (t HitsTable) TableHeader(wide bool) []string {
+	header := []string{"index", "id"}
+	if wide {
+		header = append(header, "score")
+	}
+	if len(t.Fields) == 0 {
+		return append(header, "source")
+	}
+	return append(header, t.Fields...)
+}
+
+// TableRows returns a row per hit.
+func (t HitsTable) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(t.Hits))
+	for _, h := range t.Hits {
+		row := []string{h.Index, h.ID}
+		if wide {
+			score := ""
+			if h.Score != nil {
+				score = fmt.Sprintf("%g", *h.Score)
+			}
+			row = append(row, score)
+		}
+		if len(t.Fields) == 0 {
+			row = append(row, string(h.Source))
+		} else {
+			var source map[string]any
+			_ = json.Unmarshal(h.Source, &source)
+			for _, f := range t.Fields {
+				row = append(row, FieldValue(source, f))
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// FieldValue returns the value of the dotted field path in the source, the objects and arrays are rendered as JSON.
+// The field name containing the dots itself is looked up first.
+func FieldValue(source map[string]any, field string) string {
+	value, found := source[field]
+	if !found {
+		value = any(source)
+		for _, key := range strings.Split(field, ".") {
+			object, ok := value.(map[string]any)
+			if !ok {
+				return ""
+			}
+			value = object[key]
+		}
+	}
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]any, []any:
+		b, _ := json.Marshal(v)
+		return string(b)
+	default:
+		return fmt.Sprint(v)
+	}
+}
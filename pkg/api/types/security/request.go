@@ -0,0 +1,203 @@
+package security
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/opensearch-project/opensearch-go/v4"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+// Kind is the kind of the security resource managed with the REST API of the security plugin.
+type Kind string
+
+const (
+	KindUser        Kind = "user"
+	KindRole        Kind = "role"
+	KindRoleMapping Kind = "role-mapping"
+	KindActionGroup Kind = "action-group"
+)
+
+// Kinds holds the supported kinds of the security resources.
+var Kinds = []Kind{KindUser, KindRole, KindRoleMapping, KindActionGroup}
+
+// resources maps the kinds to the REST API endpoints.
+var resources = map[Kind]string{
+	KindUser:        "internalusers",
+	KindRole:        "roles",
+	KindRoleMapping: "rolesmapping",
+	KindActionGroup: "actiongroups",
+}
+
+// readOnlyFields are returned by the get requests and rejected by the put requests.
+var readOnlyFields = []string{"reserved", "hidden", "static"}
+
+// Path returns the path of the resource, the path of all resources of the kind if the name is empty.
+// The name is escaped, it may contain the characters reserved in the path, e.g. the '/' or the spaces.
+func (k Kind) Path(name string) string {
+	path := "/_plugins/_security/api/" + resources[k]
+	if name != "" {
+		path += "/" + url.PathEscape(name)
+	}
+	return path
+}
+
+// GetResourceReq request type for the get requests of https://docs.opensearch.org/2.19/security/access-control/api/
+// returns all resources of the kind if the name is empty.
+type GetResourceReq struct {
+	Header http.Header
+	Kind   Kind
+	Name   string
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r GetResourceReq) GetRequest() (*http.Request, error) {
+	return opensearch.BuildRequest(
+		"GET",
+		r.Kind.Path(r.Name),
+		nil,
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// PutResourceReq request type for the create or replace requests of https://docs.opensearch.org/2.19/security/access-control/api/
+type PutResourceReq struct {
+	Header http.Header
+	Kind   Kind
+	Name   string
+	Body   json.RawMessage
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r PutResourceReq) GetRequest() (*http.Request, error) {
+	return opensearch.BuildRequest(
+		"PUT",
+		r.Kind.Path(r.Name),
+		bytes.NewReader(r.Body),
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// DeleteResourceReq request type for the delete requests of https://docs.opensearch.org/2.19/security/access-control/api/
+type DeleteResourceReq struct {
+	Header http.Header
+	Kind   Kind
+	Name   string
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r DeleteResourceReq) GetRequest() (*http.Request, error) {
+	return opensearch.BuildRequest(
+		"DELETE",
+		r.Kind.Path(r.Name),
+		nil,
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// AuthInfoReq request type for https://docs.opensearch.org/2.19/security/access-control/api/#get-account-details
+type AuthInfoReq struct {
+	Header http.Header
+}
+
+// GetRequest returns the *http.Request that gets executed by the client
+func (r AuthInfoReq) GetRequest() (*http.Request, error) {
+	return opensearch.BuildRequest(
+		"GET",
+		"/_plugins/_security/authinfo",
+		nil,
+		make(map[string]string),
+		r.Header,
+	)
+}
+
+// Definition returns the body of the put request from the resource definition, which is either the request body,
+// the output of the get command or the raw get response with the single resource named as the put one.
+// The read-only fields of the get response are dropped.
+func Definition(name string, data []byte) (json.RawMessage, error) {
+	var definition map[string]json.RawMessage
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return nil, fmt.Errorf("definition is not a valid JSON object:%w", err)
+	}
+	if named := definition[name]; len(definition) == 1 && named != nil {
+		definition = nil
+		if err := json.Unmarshal(named, &definition); err != nil {
+			return nil, fmt.Errorf("definition of '%s' is not a valid JSON object:%w", name, err)
+		}
+	}
+	for _, field := range readOnlyFields {
+		delete(definition, field)
+	}
+	return json.Marshal(definition)
+}
+
+// MapUsers returns the body of the role mapping with the users added to the existing mapping, which can be nil.
+func MapUsers(existing json.RawMessage, users []string) (json.RawMessage, error) {
+	mapping := map[string]any{}
+	if existing != nil {
+		if err := json.Unmarshal(existing, &mapping); err != nil {
+			return nil, fmt.Errorf("role mapping is not a valid JSON object:%w", err)
+		}
+	}
+	var mapped []string
+	if current, ok := mapping["users"].([]any); ok {
+		for _, user := range current {
+			mapped = append(mapped, fmt.Sprint(user))
+		}
+	}
+	for _, user := range users {
+		if !slices.Contains(mapped, user) {
+			mapped = append(mapped, user)
+		}
+	}
+	mapping["users"] = mapped
+	for _, field := range readOnlyFields {
+		delete(mapping, field)
+	}
+	return json.Marshal(mapping)
+}
+
+// Actions of the cross-cluster replication roles prescribed by
+// https://docs.opensearch.org/2.19/tuning-your-cluster/replication-plugin/permissions/
+var (
+	ccrLeaderIndexActions = []string{
+		"indices:admin/plugins/replication/index/setup/validate",
+		"indices:data/read/plugins/replication/file_chunk",
+		"indices:data/read/plugins/replication/changes",
+	}
+	ccrFollowerClusterActions = []string{
+		"cluster:admin/plugins/replication/autofollow/update",
+	}
+	ccrFollowerIndexActions = []string{
+		"indices:admin/plugins/replication/index/setup/validate",
+		"indices:data/write/plugins/replication/changes",
+		"indices:admin/plugins/replication/index/start",
+		"indices:admin/plugins/replication/index/pause",
+		"indices:admin/plugins/replication/index/resume",
+		"indices:admin/plugins/replication/index/stop",
+		"indices:admin/plugins/replication/index/update",
+		"indices:admin/plugins/replication/index/status_check",
+	}
+)
+
+// CCRLeaderRole returns the body of the role used on the leader cluster by the replication of the indices.
+func CCRLeaderRole(indexPatterns []string) Role {
+	return Role{
+		Description:      "cross-cluster replication leader role",
+		IndexPermissions: []IndexPermission{{IndexPatterns: indexPatterns, AllowedActions: ccrLeaderIndexActions}},
+	}
+}
+
+// CCRFollowerRole returns the body of the role used on the follower cluster by the replication of the indices.
+func CCRFollowerRole(indexPatterns []string) Role {
+	return Role{
+		Description:        "cross-cluster replication follower role",
+		ClusterPermissions: ccrFollowerClusterActions,
+		IndexPermissions:   []IndexPermission{{IndexPatterns: indexPatterns, AllowedActions: ccrFollowerIndexActions}},
+	}
+}
@@ -0,0 +1,63 @@
+package security
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKind_Path(t *testing.T) {
+	assert.Equal(t, "/_plugins/_security/api/internalusers", KindUser.Path(""))
+	assert.Equal(t, "/_plugins/_security/api/rolesmapping/app", KindRoleMapping.Path("app"))
+	assert.Equal(t, "/_plugins/_security/api/actiongroups/read", KindActionGroup.Path("read"))
+	assert.Equal(t, "/_plugins/_security/api/internalusers/ci%2Fdeploy%20bot", KindUser.Path("ci/deploy bot"))
+	req, err := DeleteResourceReq{Kind: KindUser, Name: "ci/deploy bot"}.GetRequest()
+	assert.NoError(t, err)
+	assert.Equal(t, "/_plugins/_security/api/internalusers/ci%2Fdeploy%20bot", req.URL.EscapedPath())
+}
+
+func TestDefinition(t *testing.T) {
+	bare := `{"cluster_permissions":["cluster_monitor"]}`
+	for name, data := range map[string]string{
+		"request body": bare,
+		"get output":   `{"reserved":false,"hidden":false,"static":false,"cluster_permissions":["cluster_monitor"]}`,
+		"get response": `{"app":{"reserved":false,"cluster_permissions":["cluster_monitor"]}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			body, err := Definition("app", []byte(data))
+			assert.NoError(t, err)
+			assert.JSONEq(t, bare, string(body))
+		})
+	}
+	_, err := Definition("app", []byte(`"app"`))
+	assert.ErrorContains(t, err, "not a valid JSON object")
+}
+
+func TestMapUsers(t *testing.T) {
+	body, err := MapUsers(nil, []string{"replicator"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"users":["replicator"]}`, string(body))
+
+	body, err = MapUsers(json.RawMessage(`{"reserved":false,"users":["admin","replicator"],"backend_roles":["ops"]}`), []string{"replicator", "other"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"users":["admin","replicator","other"],"backend_roles":["ops"]}`, string(body))
+}
+
+func TestCCRRoles(t *testing.T) {
+	leader, err := json.Marshal(CCRLeaderRole([]string{"orders-*"}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"description": "cross-cluster replication leader role",
+		"index_permissions": [{
+			"index_patterns": ["orders-*"],
+			"allowed_actions": [
+				"indices:admin/plugins/replication/index/setup/validate",
+				"indices:data/read/plugins/replication/file_chunk",
+				"indices:data/read/plugins/replication/changes"
+			]
+		}]
+	}`, string(leader))
+	follower := CCRFollowerRole([]string{"*"})
+	assert.Equal(t, []string{"cluster:admin/plugins/replication/autofollow/update"}, follower.ClusterPermissions)
+	assert.Contains(t, follower.IndexPermissions[0].AllowedActions, "indices:admin/plugins/replication/index/start")
+}
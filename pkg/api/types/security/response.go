@@ -0,0 +1,236 @@
+package security
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Flags is the common part of the security resources marking the resources which can't be changed with the REST API.
+type Flags struct {
+	Reserved bool `json:"reserved,omitempty"`
+	Hidden   bool `json:"hidden,omitempty"`
+	Static   bool `json:"static,omitempty"`
+}
+
+// String returns the comma separated names of the set flags.
+func (f Flags) String() string {
+	var names []string
+	for name, set := range map[string]bool{"reserved": f.Reserved, "hidden": f.Hidden, "static": f.Static} {
+		if set {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return strings.Join(names, ",")
+}
+
+// User is the internal user of the security plugin.
+type User struct {
+	Flags
+	Description   string            `json:"description,omitempty"`
+	BackendRoles  []string          `json:"backend_roles,omitempty"`
+	SecurityRoles []string          `json:"opendistro_security_roles,omitempty"`
+	Attributes    map[string]string `json:"attributes,omitempty"`
+}
+
+// Users holds the internal users keyed by the name.
+type Users map[string]User
+
+// TableHeader returns the column names of the users table.
+func (u Users) TableHeader(_ bool) []string {
+	return []string{"user", "backend roles", "security roles", "attributes", "flags", "description"}
+}
+
+// TableRows returns a row per user sorted by the name.
+func (u Users) TableRows(_ bool) [][]string {
+	rows := make([][]string, 0, len(u))
+	for _, name := range slices.Sorted(maps.Keys(u)) {
+		user := u[name]
+		attributes := make([]string, 0, len(user.Attributes))
+		for _, key := range slices.Sorted(maps.Keys(user.Attributes)) {
+			attributes = append(attributes, key+"="+user.Attributes[key])
+		}
+		rows = append(rows, []string{
+			name,
+			strings.Join(user.BackendRoles, ", "),
+			strings.Join(user.SecurityRoles, ", "),
+			strings.Join(attributes, ", "),
+			user.Flags.String(),
+			user.Description,
+		})
+	}
+	return rows
+}
+
+// Role is the set of the permissions of the security plugin.
+type Role struct {
+	Flags
+	Description        string             `json:"description,omitempty"`
+	ClusterPermissions []string           `json:"cluster_permissions,omitempty"`
+	IndexPermissions   []IndexPermission  `json:"index_permissions,omitempty"`
+	TenantPermissions  []TenantPermission `json:"tenant_permissions,omitempty"`
+}
+
+type IndexPermission struct {
+	IndexPatterns  []string `json:"index_patterns"`
+	DLS            string   `json:"dls,omitempty"`
+	FLS            []string `json:"fls,omitempty"`
+	MaskedFields   []string `json:"masked_fields,omitempty"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+type TenantPermission struct {
+	TenantPatterns []string `json:"tenant_patterns"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+// Roles holds the roles keyed by the name.
+type Roles map[string]Role
+
+// TableHeader returns the column names of the roles table, the wide table lists the index permissions.
+func (r Roles) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"role", "cluster permissions", "index permissions", "tenant patterns", "flags", "description"}
+	}
+	return []string{"role", "cluster permissions", "index patterns", "flags", "description"}
+}
+
+// TableRows returns a row per role sorted by the name.
+func (r Roles) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, name := range slices.Sorted(maps.Keys(r)) {
+		role := r[name]
+		var patterns, permissions, tenants []string
+		for _, permission := range role.IndexPermissions {
+			patterns = append(patterns, permission.IndexPatterns...)
+			permissions = append(permissions,
+				strings.Join(permission.IndexPatterns, ",")+": "+strings.Join(permission.AllowedActions, ","))
+		}
+		for _, permission := range role.TenantPermissions {
+			tenants = append(tenants, permission.TenantPatterns...)
+		}
+		if wide {
+			rows = append(rows, []string{
+				name,
+				strings.Join(role.ClusterPermissions, ", "),
+				strings.Join(permissions, "; "),
+				strings.Join(tenants, ", "),
+				role.Flags.String(),
+				role.Description,
+			})
+		} else {
+			rows = append(rows, []string{
+				name,
+				strings.Join(role.ClusterPermissions, ", "),
+				strings.Join(slices.Compact(slices.Sorted(slices.Values(patterns))), ", "),
+				role.Flags.String(),
+				role.Description,
+			})
+		}
+	}
+	return rows
+}
+
+// RoleMapping maps the users, the backend roles and the hosts to the role named as the mapping.
+type RoleMapping struct {
+	Flags
+	Description     string   `json:"description,omitempty"`
+	Users           []string `json:"users,omitempty"`
+	BackendRoles    []string `json:"backend_roles,omitempty"`
+	AndBackendRoles []string `json:"and_backend_roles,omitempty"`
+	Hosts           []string `json:"hosts,omitempty"`
+}
+
+// RoleMappings holds the role mappings keyed by the role name.
+type RoleMappings map[string]RoleMapping
+
+// TableHeader returns the column names of the role mappings table.
+func (r RoleMappings) TableHeader(_ bool) []string {
+	return []string{"role", "users", "backend roles", "hosts", "flags", "description"}
+}
+
+// TableRows returns a row per role mapping sorted by the role name.
+func (r RoleMappings) TableRows(_ bool) [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, name := range slices.Sorted(maps.Keys(r)) {
+		mapping := r[name]
+		backendRoles := slices.Clone(mapping.BackendRoles)
+		if len(mapping.AndBackendRoles) > 0 {
+			backendRoles = append(backendRoles, "all of("+strings.Join(mapping.AndBackendRoles, ", ")+")")
+		}
+		rows = append(rows, []string{
+			name,
+			strings.Join(mapping.Users, ", "),
+			strings.Join(backendRoles, ", "),
+			strings.Join(mapping.Hosts, ", "),
+			mapping.Flags.String(),
+			mapping.Description,
+		})
+	}
+	return rows
+}
+
+// ActionGroup is the named set of the actions, which can be used in the roles instead of the actions.
+type ActionGroup struct {
+	Flags
+	Description    string   `json:"description,omitempty"`
+	Type           string   `json:"type,omitempty"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+// ActionGroups holds the action groups keyed by the name.
+type ActionGroups map[string]ActionGroup
+
+// TableHeader returns the column names of the action groups table.
+func (a ActionGroups) TableHeader(_ bool) []string {
+	return []string{"action group", "type", "allowed actions", "flags", "description"}
+}
+
+// TableRows returns a row per action group sorted by the name.
+func (a ActionGroups) TableRows(_ bool) [][]string {
+	rows := make([][]string, 0, len(a))
+	for _, name := range slices.Sorted(maps.Keys(a)) {
+		group := a[name]
+		rows = append(rows, []string{name, group.Type, strings.Join(group.AllowedActions, ", "), group.Flags.String(), group.Description})
+	}
+	return rows
+}
+
+// AuthInfo describes the authenticated user of the request.
+type AuthInfo struct {
+	UserName      string          `json:"user_name"`
+	BackendRoles  []string        `json:"backend_roles"`
+	Roles         []string        `json:"roles"`
+	Tenants       map[string]bool `json:"tenants"`
+	RemoteAddress string          `json:"remote_address"`
+	Principal     *string         `json:"principal"`
+}
+
+// TableHeader returns the column names of the auth info table.
+func (a AuthInfo) TableHeader(_ bool) []string {
+	return []string{"property", "value"}
+}
+
+// TableRows returns a row per property, the tenants are marked as rw or ro.
+func (a AuthInfo) TableRows(_ bool) [][]string {
+	tenants := make([]string, 0, len(a.Tenants))
+	for _, tenant := range slices.Sorted(maps.Keys(a.Tenants)) {
+		if a.Tenants[tenant] {
+			tenants = append(tenants, tenant+"(rw)")
+		} else {
+			tenants = append(tenants, tenant+"(ro)")
+		}
+	}
+	rows := [][]string{
+		{"user", a.UserName},
+		{"backend roles", strings.Join(a.BackendRoles, ", ")},
+		{"roles", strings.Join(a.Roles, ", ")},
+		{"tenants", strings.Join(tenants, ", ")},
+		{"remote address", a.RemoteAddress},
+	}
+	if a.Principal != nil {
+		rows = append(rows, []string{"principal", *a.Principal})
+	}
+	return rows
+}
@@ -0,0 +1,51 @@
+package security
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRoles_TableRows(t *testing.T) {
+	var roles Roles
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"app": {"reserved": false, "hidden": false, "static": false, "description": "app role",
+			"cluster_permissions": ["cluster_monitor"],
+			"index_permissions": [
+				{"index_patterns": ["app-*"], "allowed_actions": ["read", "write"]},
+				{"index_patterns": ["app-*", "audit"], "allowed_actions": ["read"]}
+			],
+			"tenant_permissions": [{"tenant_patterns": ["global_tenant"], "allowed_actions": ["kibana_all_read"]}]},
+		"all_access": {"reserved": true, "static": true, "cluster_permissions": ["*"]}
+	}`), &roles))
+	assert.Equal(t, [][]string{
+		{"all_access", "*", "", "reserved,static", ""},
+		{"app", "cluster_monitor", "app-*, audit", "", "app role"},
+	}, roles.TableRows(false))
+	assert.Equal(t, []string{"app", "cluster_monitor", "app-*: read,write; app-*,audit: read", "global_tenant", "", "app role"},
+		roles.TableRows(true)[1])
+}
+
+func TestRoleMappings_TableRows(t *testing.T) {
+	mappings := RoleMappings{
+		"app": {Users: []string{"bob"}, BackendRoles: []string{"dev"}, AndBackendRoles: []string{"a", "b"}, Hosts: []string{"*.local"}},
+	}
+	assert.Equal(t, [][]string{{"app", "bob", "dev, all of(a, b)", "*.local", "", ""}}, mappings.TableRows(false))
+}
+
+func TestAuthInfo_TableRows(t *testing.T) {
+	var info AuthInfo
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"user": "User [name=admin, backend_roles=[admin], requestedTenant=null]",
+		"user_name": "admin", "user_requested_tenant": null, "remote_address": "10.0.0.1:5000",
+		"backend_roles": ["admin"], "roles": ["own_index", "all_access"],
+		"tenants": {"global_tenant": true, "admin_tenant": false}, "principal": null
+	}`), &info))
+	assert.Equal(t, [][]string{
+		{"user", "admin"},
+		{"backend roles", "admin"},
+		{"roles", "own_index, all_access"},
+		{"tenants", "admin_tenant(ro), global_tenant(rw)"},
+		{"remote address", "10.0.0.1:5000"},
+	}, info.TableRows(false))
+}
@@ -0,0 +1,169 @@
+package snapshots
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// RepositoryFS is the shared file system repository, its location must be listed in the 'path.repo' node setting.
+	RepositoryFS = "fs"
+	// RepositoryS3 is the Amazon S3 repository provided by the repository-s3 plugin.
+	RepositoryS3 = "s3"
+)
+
+// RepositoryTypes holds the supported repository types.
+var RepositoryTypes = []string{RepositoryFS, RepositoryS3}
+
+// requiredSettings holds the settings the repository of the type can't be registered without.
+var requiredSettings = map[string]string{RepositoryFS: "location", RepositoryS3: "bucket"}
+
+// RepositoryBody returns the body of the repository registration, the settings are the key=value pairs,
+// the setting required by the repository type must be present.
+func RepositoryBody(repositoryType string, pairs []string) (map[string]any, error) {
+	required, ok := requiredSettings[repositoryType]
+	if !ok {
+		return nil, fmt.Errorf("unknown repository type '%s', supported types: %s", repositoryType, strings.Join(RepositoryTypes, "|"))
+	}
+	settings, err := gu.ParseKeyValues(pairs)
+	if err != nil {
+		return nil, err
+	}
+	if v, _ := settings[required].(string); v == "" {
+		return nil, fmt.Errorf("setting '%s' is required by the '%s' repository", required, repositoryType)
+	}
+	return map[string]any{"type": repositoryType, "settings": settings}, nil
+}
+
+// CreateOptions holds the options of the snapshot creation.
+type CreateOptions struct {
+	// Indices to include in the snapshot, all indices if it is empty.
+	Indices            []string
+	IncludeGlobalState bool
+	// Partial allows the snapshot of the indices with the unavailable primary shards.
+	Partial bool
+}
+
+// Body returns the body of the snapshot creation request.
+func (o CreateOptions) Body() ([]byte, error) {
+	body := map[string]any{
+		"include_global_state": o.IncludeGlobalState,
+		"partial":              o.Partial,
+	}
+	if len(o.Indices) > 0 {
+		body["indices"] = strings.Join(o.Indices, ",")
+	}
+	return json.Marshal(body)
+}
+
+// RestoreOptions holds the options of the snapshot restore.
+type RestoreOptions struct {
+	// Indices to restore, the names or the patterns, all indices of the snapshot if it is empty.
+	Indices []string
+	// RenamePattern is the regular expression matched against the restored index names,
+	// the matches are replaced by RenameReplacement which can refer to the capture groups as $1.
+	RenamePattern      string
+	RenameReplacement  string
+	IncludeAliases     bool
+	IncludeGlobalState bool
+	Partial            bool
+	// IndexSettings override the settings of the restored indices.
+	IndexSettings map[string]any
+}
+
+// Validate checks the rename options are consistent and the rename pattern is valid.
+func (o RestoreOptions) Validate() error {
+	if o.RenamePattern == "" {
+		if o.RenameReplacement != "" {
+			return errors.New("rename replacement requires the rename pattern")
+		}
+		return nil
+	}
+	if _, err := regexp.Compile(o.RenamePattern); err != nil {
+		return fmt.Errorf("invalid rename pattern '%s':%w", o.RenamePattern, err)
+	}
+	return nil
+}
+
+// Rename returns the name the index is restored with.
+func (o RestoreOptions) Rename(index string) string {
+	if o.RenamePattern == "" {
+		return index
+	}
+	re, err := regexp.Compile(o.RenamePattern)
+	if err != nil {
+		return index
+	}
+	return re.ReplaceAllString(index, o.RenameReplacement)
+}
+
+// Body returns the body of the snapshot restore request.
+func (o RestoreOptions) Body() ([]byte, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	body := map[string]any{
+		"include_aliases":      o.IncludeAliases,
+		"include_global_state": o.IncludeGlobalState,
+		"partial":              o.Partial,
+	}
+	if len(o.Indices) > 0 {
+		body["indices"] = strings.Join(o.Indices, ",")
+	}
+	if o.RenamePattern != "" {
+		body["rename_pattern"] = o.RenamePattern
+		body["rename_replacement"] = o.RenameReplacement
+	}
+	if len(o.IndexSettings) > 0 {
+		body["index_settings"] = o.IndexSettings
+	}
+	return json.Marshal(body)
+}
+
+// Plan returns the restored indices of the snapshot selected by the names or the patterns mapped to their restored names,
+// all indices of the snapshot are selected if the selection is empty or starts with the exclusion.
+// The names starting with '-' exclude the indices selected by the preceding names.
+func (o RestoreOptions) Plan(snapshotIndices []string, match func(pattern string) func(string) bool) (map[string]string, error) {
+	selection := o.Indices
+	if len(selection) == 0 || strings.HasPrefix(selection[0], "-") {
+		selection = append([]string{"*"}, selection...)
+	}
+	selected := map[string]bool{}
+	for _, pattern := range selection {
+		exclude := strings.HasPrefix(pattern, "-")
+		matches := match(strings.TrimPrefix(pattern, "-"))
+		found := false
+		for _, index := range snapshotIndices {
+			if matches(index) {
+				found = true
+				selected[index] = !exclude
+			}
+		}
+		if !found && !exclude {
+			return nil, fmt.Errorf("no indices of the snapshot match '%s'", pattern)
+		}
+	}
+	plan := map[string]string{}
+	for _, index := range snapshotIndices {
+		if selected[index] {
+			plan[index] = o.Rename(index)
+		}
+	}
+	if len(plan) == 0 {
+		return nil, errors.New("no indices of the snapshot are selected")
+	}
+	targets := map[string]string{}
+	for _, source := range slices.Sorted(maps.Keys(plan)) {
+		if other, ok := targets[plan[source]]; ok {
+			return nil, fmt.Errorf("indices '%s' and '%s' are both restored as '%s'", other, source, plan[source])
+		}
+		targets[plan[source]] = source
+	}
+	return plan, nil
+}
@@ -0,0 +1,98 @@
+package snapshots
+
+import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRepositoryBody(t *testing.T) {
+	body, err := RepositoryBody(RepositoryS3, []string{"bucket=backups", "base_path=os", "compress=true"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"type":     RepositoryS3,
+		"settings": map[string]any{"bucket": "backups", "base_path": "os", "compress": "true"},
+	}, body)
+
+	_, err = RepositoryBody(RepositoryFS, []string{"compress=true"})
+	assert.ErrorContains(t, err, "'location' is required")
+	_, err = RepositoryBody("hdfs", []string{"path=/x"})
+	assert.ErrorContains(t, err, "unknown repository type")
+	_, err = RepositoryBody(RepositoryFS, []string{"location"})
+	assert.ErrorContains(t, err, "key=value")
+}
+
+func TestRestoreOptions_Body(t *testing.T) {
+	opts := RestoreOptions{
+		Indices:           []string{"a", "b"},
+		RenamePattern:     "(.+)",
+		RenameReplacement: "restored-$1",
+		IndexSettings:     map[string]any{"index.number_of_replicas": "0"},
+	}
+	body, err := opts.Body()
+	assert.NoError(t, err)
+	var decoded map[string]any
+	assert.NoError(t, json.Unmarshal(body, &decoded))
+	assert.Equal(t, map[string]any{
+		"indices":              "a,b",
+		"rename_pattern":       "(.+)",
+		"rename_replacement":   "restored-$1",
+		"include_aliases":      false,
+		"include_global_state": false,
+		"partial":              false,
+		"index_settings":       map[string]any{"index.number_of_replicas": "0"},
+	}, decoded)
+
+	_, err = RestoreOptions{RenameReplacement: "x"}.Body()
+	assert.Error(t, err)
+	_, err = RestoreOptions{RenamePattern: "(", RenameReplacement: "x"}.Body()
+	assert.ErrorContains(t, err, "invalid rename pattern")
+}
+
+func TestRestoreOptions_Plan(t *testing.T) {
+	snapshotIndices := []string{"logs-1", "logs-2", "logs-old", "orders"}
+	tests := []struct {
+		name     string
+		opts     RestoreOptions
+		expected map[string]string
+		wantErr  string
+	}{
+		{
+			name:     "all indices",
+			opts:     RestoreOptions{},
+			expected: map[string]string{"logs-1": "logs-1", "logs-2": "logs-2", "logs-old": "logs-old", "orders": "orders"},
+		},
+		{
+			name:     "pattern with exclusion and rename",
+			opts:     RestoreOptions{Indices: []string{"logs-*", "-logs-old"}, RenamePattern: "^logs-", RenameReplacement: "restored-logs-"},
+			expected: map[string]string{"logs-1": "restored-logs-1", "logs-2": "restored-logs-2"},
+		},
+		{
+			name:     "leading exclusion selects the rest",
+			opts:     RestoreOptions{Indices: []string{"-logs-*"}},
+			expected: map[string]string{"orders": "orders"},
+		},
+		{
+			name:    "missing index",
+			opts:    RestoreOptions{Indices: []string{"users"}},
+			wantErr: "no indices of the snapshot match 'users'",
+		},
+		{
+			name:    "rename collision",
+			opts:    RestoreOptions{Indices: []string{"logs-*"}, RenamePattern: "logs-.*", RenameReplacement: "logs"},
+			wantErr: "are both restored as 'logs'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := tt.opts.Plan(snapshotIndices, generic.GetMatchFunc)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, plan)
+		})
+	}
+}
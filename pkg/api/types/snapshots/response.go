@@ -0,0 +1,317 @@
+package snapshots
+
+import (
+	"cmp"
+	"fmt"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	StateInProgress = "IN_PROGRESS"
+	StateSuccess    = "SUCCESS"
+	StatePartial    = "PARTIAL"
+	StateFailed     = "FAILED"
+	// stateStarted is the state of the running snapshot reported by the status API.
+	stateStarted = "STARTED"
+)
+
+// stateIcons marks the snapshot states in the tables.
+var stateIcons = map[string]string{
+	StateSuccess:    "✅",
+	StatePartial:    "⚠️",
+	StateInProgress: "⏳",
+	stateStarted:    "⏳",
+	StateFailed:     "❌",
+	"ABORTED":       "❌",
+}
+
+// IsCompleted returns true if the snapshot in the state is not running anymore.
+func IsCompleted(state string) bool {
+	return state != StateInProgress && state != stateStarted && state != "INIT"
+}
+
+// Repository is the registered snapshot repository.
+type Repository struct {
+	Type     string         `json:"type"`
+	Settings map[string]any `json:"settings"`
+}
+
+// Repositories holds the repositories keyed by the name.
+type Repositories map[string]Repository
+
+// TableHeader returns the column names of the repositories table.
+func (r Repositories) TableHeader(_ bool) []string {
+	return []string{"name", "type", "settings"}
+}
+
+// TableRows returns a row per repository sorted by the name.
+func (r Repositories) TableRows(_ bool) [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, name := range slices.Sorted(maps.Keys(r)) {
+		settings := gu.FlattenValues(r[name].Settings)
+		pairs := make([]string, 0, len(settings))
+		for _, key := range slices.Sorted(maps.Keys(settings)) {
+			pairs = append(pairs, key+"="+settings[key])
+		}
+		rows = append(rows, []string{name, r[name].Type, strings.Join(pairs, ", ")})
+	}
+	return rows
+}
+
+// VerifiedNode is the node which verified the access to the repository.
+type VerifiedNode struct {
+	Name string `json:"name"`
+}
+
+// Verification is the result of the repository verification.
+type Verification struct {
+	Nodes map[string]VerifiedNode `json:"nodes"`
+}
+
+// TableHeader returns the column names of the verification table.
+func (v Verification) TableHeader(_ bool) []string {
+	return []string{"node", "name"}
+}
+
+// TableRows returns a row per node which has access to the repository.
+func (v Verification) TableRows(_ bool) [][]string {
+	rows := make([][]string, 0, len(v.Nodes))
+	for _, id := range slices.Sorted(maps.Keys(v.Nodes)) {
+		rows = append(rows, []string{id, v.Nodes[id].Name})
+	}
+	return rows
+}
+
+// ShardsSummary is the number of the shards of the snapshot.
+type ShardsSummary struct {
+	Total      int `json:"total"`
+	Failed     int `json:"failed"`
+	Successful int `json:"successful"`
+}
+
+// Snapshot describes the snapshot stored in the repository.
+type Snapshot struct {
+	Snapshot           string        `json:"snapshot"`
+	UUID               string        `json:"uuid"`
+	Repository         string        `json:"repository,omitempty"`
+	State              string        `json:"state"`
+	Indices            []string      `json:"indices"`
+	IncludeGlobalState bool          `json:"include_global_state"`
+	StartTimeInMillis  int64         `json:"start_time_in_millis"`
+	EndTimeInMillis    int64         `json:"end_time_in_millis"`
+	DurationInMillis   int64         `json:"duration_in_millis"`
+	Failures           []any         `json:"failures,omitempty"`
+	Shards             ShardsSummary `json:"shards"`
+}
+
+// Snapshots is the list of the snapshots, it is rendered as a table.
+type Snapshots []Snapshot
+
+// Sort sorts the snapshots by the start time, the oldest first.
+func (s Snapshots) Sort() {
+	slices.SortStableFunc(s, func(a, b Snapshot) int {
+		return cmp.Compare(a.StartTimeInMillis, b.StartTimeInMillis)
+	})
+}
+
+// Names returns the names of the snapshots.
+func (s Snapshots) Names() []string {
+	names := make([]string, 0, len(s))
+	for _, snapshot := range s {
+		names = append(names, snapshot.Snapshot)
+	}
+	return names
+}
+
+// TableHeader returns the column names of the snapshots table.
+func (s Snapshots) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"snapshot", "state", "indices", "shards", "failed", "started", "duration", "uuid", "global state", "index names"}
+	}
+	return []string{"snapshot", "state", "indices", "shards", "failed", "started", "duration"}
+}
+
+// TableRows returns a row per snapshot.
+func (s Snapshots) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(s))
+	for _, snapshot := range s {
+		row := []string{
+			snapshot.Snapshot,
+			stateIcons[snapshot.State] + snapshot.State,
+			strconv.Itoa(len(snapshot.Indices)),
+			fmt.Sprintf("%d/%d", snapshot.Shards.Successful, snapshot.Shards.Total),
+			strconv.Itoa(snapshot.Shards.Failed),
+			formatMillis(snapshot.StartTimeInMillis),
+			(time.Duration(snapshot.DurationInMillis) * time.Millisecond).String(),
+		}
+		if wide {
+			row = append(row, snapshot.UUID, strconv.FormatBool(snapshot.IncludeGlobalState), strings.Join(snapshot.Indices, ","))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ShardsStats is the number of the shards of the snapshot in each stage.
+type ShardsStats struct {
+	Initializing int `json:"initializing"`
+	Started      int `json:"started"`
+	Finalizing   int `json:"finalizing"`
+	Done         int `json:"done"`
+	Failed       int `json:"failed"`
+	Total        int `json:"total"`
+}
+
+// FileStats is the number and the size of the snapshot files.
+type FileStats struct {
+	FileCount   int64 `json:"file_count"`
+	SizeInBytes int64 `json:"size_in_bytes"`
+}
+
+// Stats holds the file statistics of the snapshot, Processed is set while the snapshot is running.
+type Stats struct {
+	Incremental       FileStats  `json:"incremental"`
+	Processed         *FileStats `json:"processed,omitempty"`
+	Total             FileStats  `json:"total"`
+	StartTimeInMillis int64      `json:"start_time_in_millis"`
+	TimeInMillis      int64      `json:"time_in_millis"`
+}
+
+// IndexStatus is the status of the snapshot of the single index.
+type IndexStatus struct {
+	ShardsStats ShardsStats `json:"shards_stats"`
+	Stats       Stats       `json:"stats"`
+}
+
+// Status is the detailed status of the snapshot.
+type Status struct {
+	Snapshot    string                 `json:"snapshot"`
+	Repository  string                 `json:"repository"`
+	UUID        string                 `json:"uuid"`
+	State       string                 `json:"state"`
+	ShardsStats ShardsStats            `json:"shards_stats"`
+	Stats       Stats                  `json:"stats"`
+	Indices     map[string]IndexStatus `json:"indices,omitempty"`
+}
+
+// Details returns the progress details shown next to the progress bar.
+func (s Status) Details() string {
+	done := s.Stats.Incremental.SizeInBytes
+	if s.Stats.Processed != nil {
+		done = s.Stats.Processed.SizeInBytes
+	} else if !IsCompleted(s.State) {
+		done = 0
+	}
+	return fmt.Sprintf("%s of %s", formatBytes(done), formatBytes(s.Stats.Incremental.SizeInBytes))
+}
+
+// Statuses is the list of the snapshot statuses, it is rendered as a table.
+type Statuses []Status
+
+// TableHeader returns the column names of the statuses table.
+func (s Statuses) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"snapshot", "index", "state", "shards", "failed", "files", "size", "time"}
+	}
+	return []string{"snapshot", "state", "shards", "failed", "files", "size", "time"}
+}
+
+// TableRows returns a row per snapshot, the wide table adds a row per index of the snapshot.
+func (s Statuses) TableRows(wide bool) [][]string {
+	var rows [][]string
+	row := func(snapshot, index, state string, shards ShardsStats, stats Stats) []string {
+		r := []string{
+			snapshot,
+			state,
+			fmt.Sprintf("%d/%d", shards.Done, shards.Total),
+			strconv.Itoa(shards.Failed),
+			fmt.Sprintf("%d/%d", stats.Incremental.FileCount, stats.Total.FileCount),
+			fmt.Sprintf("%s/%s", formatBytes(stats.Incremental.SizeInBytes), formatBytes(stats.Total.SizeInBytes)),
+			(time.Duration(stats.TimeInMillis) * time.Millisecond).String(),
+		}
+		if wide {
+			r = slices.Insert(r, 1, index)
+		}
+		return r
+	}
+	for _, status := range s {
+		rows = append(rows, row(status.Snapshot, "", stateIcons[status.State]+status.State, status.ShardsStats, status.Stats))
+		if wide {
+			for _, index := range slices.Sorted(maps.Keys(status.Indices)) {
+				rows = append(rows, row(status.Snapshot, index, "", status.Indices[index].ShardsStats, status.Indices[index].Stats))
+			}
+		}
+	}
+	return rows
+}
+
+// RecoverySize is the size of the recovered shard files.
+type RecoverySize struct {
+	TotalInBytes     int64 `json:"total_in_bytes"`
+	RecoveredInBytes int64 `json:"recovered_in_bytes"`
+}
+
+// ShardRecovery is the recovery of the single shard copy.
+type ShardRecovery struct {
+	ID      int    `json:"id"`
+	Type    string `json:"type"`
+	Stage   string `json:"stage"`
+	Primary bool   `json:"primary"`
+	Index   struct {
+		Size RecoverySize `json:"size"`
+	} `json:"index"`
+}
+
+// Recovery holds the shard recoveries keyed by the index name.
+type Recovery map[string]struct {
+	Shards []ShardRecovery `json:"shards"`
+}
+
+// RestoreProgress returns the number of the primary shards restored from the snapshot, and the recovered and total bytes.
+func (r Recovery) RestoreProgress() (done int64, recovered int64, total int64) {
+	for _, index := range r {
+		for _, shard := range index.Shards {
+			if shard.Type != "SNAPSHOT" || !shard.Primary {
+				continue
+			}
+			if shard.Stage == "DONE" {
+				done++
+			}
+			recovered += shard.Index.Size.RecoveredInBytes
+			total += shard.Index.Size.TotalInBytes
+		}
+	}
+	return done, recovered, total
+}
+
+// RestoreDetails returns the restore progress details shown next to the progress bar.
+func RestoreDetails(recovered, total int64) string {
+	return fmt.Sprintf("%s of %s", formatBytes(recovered), formatBytes(total))
+}
+
+// formatMillis formats the epoch milliseconds as RFC 3339, empty if it is not set.
+func formatMillis(millis int64) string {
+	if millis <= 0 {
+		return ""
+	}
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
+
+// formatBytes formats the size with the binary unit.
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%db", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%cb", float64(size)/float64(div), "kmgtpe"[exp])
+}
@@ -0,0 +1,54 @@
+package snapshots
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSnapshots_TableRows(t *testing.T) {
+	list := Snapshots{
+		{Snapshot: "b", State: StatePartial, Indices: []string{"x", "y"}, StartTimeInMillis: 2000, DurationInMillis: 1500,
+			Shards: ShardsSummary{Total: 2, Failed: 1, Successful: 1}},
+		{Snapshot: "a", State: StateSuccess, Indices: []string{"x"}, StartTimeInMillis: 1000, DurationInMillis: 200,
+			Shards: ShardsSummary{Total: 1, Successful: 1}},
+	}
+	list.Sort()
+	assert.Equal(t, []string{"a", "b"}, list.Names())
+	assert.Equal(t, [][]string{
+		{"a", "✅SUCCESS", "1", "1/1", "0", "1970-01-01T00:00:01Z", "200ms"},
+		{"b", "⚠️PARTIAL", "2", "1/2", "1", "1970-01-01T00:00:02Z", "1.5s"},
+	}, list.TableRows(false))
+}
+
+func TestStatus_Details(t *testing.T) {
+	var status Status
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"snapshot": "a",
+		"state": "STARTED",
+		"shards_stats": {"done": 1, "total": 3},
+		"stats": {"incremental": {"file_count": 10, "size_in_bytes": 3145728}, "processed": {"file_count": 4, "size_in_bytes": 1536}}
+	}`), &status))
+	assert.False(t, IsCompleted(status.State))
+	assert.Equal(t, "1.5kb of 3.0mb", status.Details())
+	status.State, status.Stats.Processed = StateSuccess, nil
+	assert.True(t, IsCompleted(status.State))
+	assert.Equal(t, "3.0mb of 3.0mb", status.Details())
+}
+
+func TestRecovery_RestoreProgress(t *testing.T) {
+	var recovery Recovery
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"a": {"shards": [
+			{"id": 0, "type": "SNAPSHOT", "stage": "DONE", "primary": true, "index": {"size": {"total_in_bytes": 100, "recovered_in_bytes": 100}}},
+			{"id": 0, "type": "PEER", "stage": "INDEX", "primary": false, "index": {"size": {"total_in_bytes": 100, "recovered_in_bytes": 10}}}
+		]},
+		"b": {"shards": [
+			{"id": 0, "type": "SNAPSHOT", "stage": "INDEX", "primary": true, "index": {"size": {"total_in_bytes": 300, "recovered_in_bytes": 50}}}
+		]}
+	}`), &recovery))
+	done, recovered, total := recovery.RestoreProgress()
+	assert.Equal(t, int64(1), done)
+	assert.Equal(t, int64(150), recovered)
+	assert.Equal(t, int64(400), total)
+}
@@ -0,0 +1,149 @@
+package stats
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// Lag returns the difference between the follower and leader checkpoints.
+func (r IndexReplicationStatsResponse) Lag() int {
+	return r.SyncingDetails.FollowerCheckpoint - r.SyncingDetails.LeaderCheckpoint
+}
+
+// IndexReplicationStatsList represents the replication status of the multiple indices.
+type IndexReplicationStatsList []IndexReplicationStatsResponse
+
+// TableHeader returns the column names of the replication status table.
+func (l IndexReplicationStatsList) TableHeader(wide bool) []string {
+	header := []string{"follower index", "status", "leader alias", "leader index", "lag"}
+	if wide {
+		header = append(header, "leader checkpoint", "follower checkpoint", "seq no", "reason")
+	}
+	return header
+}
+
+// TableRows returns the rows of the replication status table.
+func (l IndexReplicationStatsList) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(l))
+	for _, r := range l {
+		row := []string{r.FollowerIndex, r.Status, r.LeaderAlias, r.LeaderIndex, strconv.Itoa(r.Lag())}
+		if wide {
+			row = append(row,
+				strconv.Itoa(r.SyncingDetails.LeaderCheckpoint),
+				strconv.Itoa(r.SyncingDetails.FollowerCheckpoint),
+				strconv.Itoa(r.SyncingDetails.SeqNo),
+				r.Reason)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// TableHeader returns the column names of the leader stats table.
+func (r ReplicationLeaderStatsResponse) TableHeader(wide bool) []string {
+	header := []string{"index", "operations read", "translog size bytes", "bytes read"}
+	if wide {
+		header = append(header, "operations read lucene", "operations read translog", "read time lucene ms", "read time translog ms")
+	}
+	return header
+}
+
+// TableRows returns a row per replicated index followed by the total row.
+func (r ReplicationLeaderStatsResponse) TableRows(wide bool) [][]string {
+	row := func(name string, s ReplicationLeaderIndexStats) []string {
+		cells := []string{name, strconv.Itoa(s.OperationsRead), strconv.Itoa(s.TranslogSizeBytes), strconv.Itoa(s.BytesRead)}
+		if wide {
+			cells = append(cells,
+				strconv.Itoa(s.OperationsReadLucene),
+				strconv.Itoa(s.OperationsReadTranslog),
+				strconv.Itoa(s.TotalReadTimeLuceneMillis),
+				strconv.Itoa(s.TotalReadTimeTranslogMillis))
+		}
+		return cells
+	}
+	rows := [][]string{}
+	for _, name := range slices.Sorted(maps.Keys(r.IndexStats)) {
+		rows = append(rows, row(name, r.IndexStats[name]))
+	}
+	return append(rows, row(fmt.Sprintf("<total:%d indices>", r.NumReplicatedIndices), ReplicationLeaderIndexStats{
+		OperationsRead:              r.OperationsRead,
+		TranslogSizeBytes:           r.TranslogSizeBytes,
+		OperationsReadLucene:        r.OperationsReadLucene,
+		OperationsReadTranslog:      r.OperationsReadTranslog,
+		TotalReadTimeLuceneMillis:   r.TotalReadTimeLuceneMillis,
+		TotalReadTimeTranslogMillis: r.TotalReadTimeTranslogMillis,
+		BytesRead:                   r.BytesRead,
+	}))
+}
+
+// TableHeader returns the column names of the follower stats table.
+func (r ReplicationFollowerStatsResponse) TableHeader(wide bool) []string {
+	header := []string{"index", "operations written", "operations read", "leader checkpoint", "follower checkpoint"}
+	if wide {
+		header = append(header, "failed read", "throttled read", "failed write", "throttled write", "write time ms")
+	}
+	return header
+}
+
+// TableRows returns a row per replicated index followed by the total row.
+func (r ReplicationFollowerStatsResponse) TableRows(wide bool) [][]string {
+	row := func(name string, s ReplicationFollowerIndexStats) []string {
+		cells := []string{
+			name,
+			strconv.Itoa(s.OperationsWritten),
+			strconv.Itoa(s.OperationsRead),
+			strconv.Itoa(s.LeaderCheckpoint),
+			strconv.Itoa(s.FollowerCheckpoint),
+		}
+		if wide {
+			cells = append(cells,
+				strconv.Itoa(s.FailedReadRequests),
+				strconv.Itoa(s.ThrottledReadRequests),
+				strconv.Itoa(s.FailedWriteRequests),
+				strconv.Itoa(s.ThrottledWriteRequests),
+				strconv.Itoa(s.TotalWriteTimeMillis))
+		}
+		return cells
+	}
+	rows := [][]string{}
+	for _, name := range slices.Sorted(maps.Keys(r.IndexStats)) {
+		rows = append(rows, row(name, r.IndexStats[name]))
+	}
+	total := fmt.Sprintf("<total:syncing=%d,bootstrapping=%d,paused=%d,failed=%d>",
+		r.NumSyncingIndices, r.NumBootstrappingIndices, r.NumPausedIndices, r.NumFailedIndices)
+	return append(rows, row(total, ReplicationFollowerIndexStats{
+		OperationsWritten:      r.OperationsWritten,
+		OperationsRead:         r.OperationsRead,
+		FailedReadRequests:     r.FailedReadRequests,
+		ThrottledReadRequests:  r.ThrottledReadRequests,
+		FailedWriteRequests:    r.FailedWriteRequests,
+		ThrottledWriteRequests: r.ThrottledWriteRequests,
+		FollowerCheckpoint:     r.FollowerCheckpoint,
+		LeaderCheckpoint:       r.LeaderCheckpoint,
+		TotalWriteTimeMillis:   r.TotalWriteTimeMillis,
+	}))
+}
+
+// TableHeader returns the column names of the autofollow rules table.
+func (r ReplicationAutoFollowStatsResponse) TableHeader(wide bool) []string {
+	header := []string{"name", "pattern", "started", "failed"}
+	if wide {
+		header = append(header, "failed leader calls", "failed indices")
+	}
+	return header
+}
+
+// TableRows returns a row per configured autofollow rule.
+func (r ReplicationAutoFollowStatsResponse) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(r.AutofollowStats))
+	for _, s := range r.AutofollowStats {
+		row := []string{s.Name, s.Pattern, strconv.Itoa(s.NumSuccessStartReplication), strconv.Itoa(s.NumFailedStartReplication)}
+		if wide {
+			row = append(row, strconv.Itoa(s.NumFailedLeaderCalls), fmt.Sprintf("%v", s.FailedIndices))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,26 @@
+package tasks
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ListOptions selects the tasks returned by the list request, the empty options select all tasks.
+type ListOptions struct {
+	// Actions are the action names or the patterns, e.g. '*reindex'.
+	Actions []string
+	// Nodes are the node identifiers or names.
+	Nodes []string
+	// ParentTaskID selects the child tasks of the task with the 'node:id' identifier.
+	ParentTaskID string
+}
+
+// IsTaskID returns true if the value is the task identifier in the form 'node:id'.
+func IsTaskID(value string) bool {
+	node, id, found := strings.Cut(value, ":")
+	if !found || node == "" {
+		return false
+	}
+	_, err := strconv.ParseInt(id, 10, 64)
+	return err == nil
+}
@@ -0,0 +1,20 @@
+package tasks
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsTaskID(t *testing.T) {
+	for value, expected := range map[string]bool{
+		"node-1:1234":                true,
+		"oTUltX4IQMOUUVeiohTt8A:124": true,
+		"node-1":                     false,
+		":1234":                      false,
+		"node-1:":                    false,
+		"indices:data/write/reindex": false,
+		"*reindex":                   false,
+	} {
+		assert.Equal(t, expected, IsTaskID(value), value)
+	}
+}
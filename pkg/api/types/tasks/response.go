@@ -0,0 +1,145 @@
+package tasks
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Task describes the running task of the cluster.
+type Task struct {
+	Node               string          `json:"node"`
+	ID                 int64           `json:"id"`
+	Type               string          `json:"type"`
+	Action             string          `json:"action"`
+	Description        string          `json:"description,omitempty"`
+	StartTimeInMillis  int64           `json:"start_time_in_millis"`
+	RunningTimeInNanos int64           `json:"running_time_in_nanos"`
+	Cancellable        bool            `json:"cancellable"`
+	Cancelled          bool            `json:"cancelled,omitempty"`
+	ParentTaskID       string          `json:"parent_task_id,omitempty"`
+	Status             json.RawMessage `json:"status,omitempty"`
+}
+
+// TaskID returns the task identifier in the form 'node:id' accepted by the tasks API.
+func (t Task) TaskID() string {
+	return fmt.Sprintf("%s:%d", t.Node, t.ID)
+}
+
+// RunningTime returns the running time of the task.
+func (t Task) RunningTime() time.Duration {
+	return time.Duration(t.RunningTimeInNanos)
+}
+
+// StartTime returns the start time of the task.
+func (t Task) StartTime() time.Time {
+	return time.UnixMilli(t.StartTimeInMillis)
+}
+
+// State returns the short state of the task shown in the tables.
+func (t Task) State() string {
+	switch {
+	case t.Cancelled:
+		return "cancelled"
+	case t.Cancellable:
+		return "cancellable"
+	}
+	return ""
+}
+
+// Tasks is the list of the running tasks.
+type Tasks []Task
+
+// Sort orders the tasks by the start time, the oldest first.
+func (t Tasks) Sort() {
+	slices.SortStableFunc(t, func(a, b Task) int {
+		return cmp.Or(cmp.Compare(a.StartTimeInMillis, b.StartTimeInMillis), strings.Compare(a.TaskID(), b.TaskID()))
+	})
+}
+
+// TableHeader returns the column names of the tasks table, the wide table adds the type and the description.
+func (t Tasks) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"task", "action", "started", "running", "state", "parent", "type", "description"}
+	}
+	return []string{"task", "action", "started", "running", "state", "parent"}
+}
+
+// TableRows returns a row per task.
+func (t Tasks) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(t))
+	for _, task := range t {
+		row := []string{
+			task.TaskID(),
+			task.Action,
+			task.StartTime().UTC().Format(time.RFC3339),
+			formatRunningTime(task.RunningTime()),
+			task.State(),
+			task.ParentTaskID,
+		}
+		if wide {
+			row = append(row, task.Type, strings.Join(strings.Fields(task.Description), " "))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// formatRunningTime rounds the running time to the precision useful for the humans.
+func formatRunningTime(running time.Duration) string {
+	if running < time.Second {
+		return running.Round(time.Millisecond).String()
+	}
+	return running.Round(time.Second).String()
+}
+
+// TaskResult is the state of the task, the response or the error is set when the task is completed.
+type TaskResult struct {
+	Completed bool            `json:"completed"`
+	Task      Task            `json:"task"`
+	Response  json.RawMessage `json:"response,omitempty"`
+	Error     json.RawMessage `json:"error,omitempty"`
+}
+
+// TableHeader returns the column names of the task table.
+func (r TaskResult) TableHeader(_ bool) []string {
+	return []string{"property", "value"}
+}
+
+// TableRows returns the properties of the task, the status, the response and the error are rendered as the compact JSON.
+func (r TaskResult) TableRows(_ bool) [][]string {
+	rows := [][]string{
+		{"task", r.Task.TaskID()},
+		{"action", r.Task.Action},
+		{"description", r.Task.Description},
+		{"started", r.Task.StartTime().UTC().Format(time.RFC3339)},
+		{"running", formatRunningTime(r.Task.RunningTime())},
+		{"cancellable", strconv.FormatBool(r.Task.Cancellable)},
+		{"cancelled", strconv.FormatBool(r.Task.Cancelled)},
+		{"parent", r.Task.ParentTaskID},
+		{"completed", strconv.FormatBool(r.Completed)},
+	}
+	for _, raw := range []struct {
+		name  string
+		value json.RawMessage
+	}{{"status", r.Task.Status}, {"response", r.Response}, {"error", r.Error}} {
+		if len(raw.value) > 0 {
+			rows = append(rows, []string{raw.name, compactJSON(raw.value)})
+		}
+	}
+	return rows
+}
+
+// compactJSON returns the JSON on the single line, the invalid JSON is returned as is.
+func compactJSON(value json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, value); err != nil {
+		return string(value)
+	}
+	return buf.String()
+}
@@ -0,0 +1,40 @@
+package tasks
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTasks_TableRows(t *testing.T) {
+	list := Tasks{
+		{Node: "n2", ID: 7, Type: "transport", Action: "indices:data/write/reindex", Description: "reindex from [a]\nto [b]",
+			StartTimeInMillis: 1700000060000, RunningTimeInNanos: 65_400_000_000, Cancellable: true, Cancelled: true},
+		{Node: "n1", ID: 9, Type: "direct", Action: "indices:data/write/bulk", StartTimeInMillis: 1700000000000,
+			RunningTimeInNanos: 1_500_000, ParentTaskID: "n2:7"},
+	}
+	list.Sort()
+	assert.Equal(t, [][]string{
+		{"n1:9", "indices:data/write/bulk", "2023-11-14T22:13:20Z", "2ms", "", "n2:7"},
+		{"n2:7", "indices:data/write/reindex", "2023-11-14T22:14:20Z", "1m5s", "cancelled", ""},
+	}, list.TableRows(false))
+	assert.Equal(t, []string{"transport", "reindex from [a] to [b]"}, list.TableRows(true)[1][6:])
+}
+
+func TestTaskResult_TableRows(t *testing.T) {
+	var result TaskResult
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"completed": true,
+		"task": {"node": "n1", "id": 5, "action": "indices:data/write/delete/byquery", "cancellable": true,
+			"start_time_in_millis": 1700000000000, "running_time_in_nanos": 3000000000,
+			"status": {"total": 10, "deleted": 10}},
+		"response": {"took": 12, "deleted": 10}
+	}`), &result))
+	rows := result.TableRows(false)
+	assert.Equal(t, []string{"task", "n1:5"}, rows[0])
+	assert.Equal(t, []string{"completed", "true"}, rows[8])
+	assert.Equal(t, [][]string{
+		{"status", `{"total":10,"deleted":10}`},
+		{"response", `{"took":12,"deleted":10}`},
+	}, rows[9:])
+}
@@ -0,0 +1,114 @@
+package templates
+
+import (
+	"encoding/json"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"maps"
+	"slices"
+	"strings"
+)
+
+const (
+	DriftChanged     = "changed"
+	DriftLocalOnly   = "local only"
+	DriftClusterOnly = "cluster only"
+)
+
+// settingsPath is the path of the settings in the template definition.
+const settingsPath = "template.settings."
+
+// Drift is the single difference between the local template definition and the cluster one.
+type Drift struct {
+	Path    string `json:"path"`
+	Change  string `json:"change"`
+	Local   string `json:"local,omitempty"`
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// Drifts is the list of the differences, it is rendered as a table.
+type Drifts []Drift
+
+// TableHeader returns the column names of the drifts table.
+func (d Drifts) TableHeader(_ bool) []string {
+	return []string{"path", "change", "local", "cluster"}
+}
+
+// TableRows returns a row per difference.
+func (d Drifts) TableRows(_ bool) [][]string {
+	rows := make([][]string, 0, len(d))
+	for _, drift := range d {
+		rows = append(rows, []string{drift.Path, drift.Change, drift.Local, drift.Cluster})
+	}
+	return rows
+}
+
+// Diff compares the local template definition with the cluster one, which is nil if the template doesn't exist.
+// The definitions are compared by their flattened values, so the settings written as '1' or 1, nested or flat,
+// with or without the 'index.' prefix are equal.
+func Diff(local, cluster json.RawMessage) (Drifts, error) {
+	localValues, err := Flatten(local)
+	if err != nil {
+		return nil, err
+	}
+	clusterValues, err := Flatten(cluster)
+	if err != nil {
+		return nil, err
+	}
+	paths := slices.Sorted(maps.Keys(localValues))
+	for path := range clusterValues {
+		if _, ok := localValues[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+	var drifts Drifts
+	for _, path := range paths {
+		localValue, inLocal := localValues[path]
+		clusterValue, inCluster := clusterValues[path]
+		switch {
+		case !inCluster:
+			drifts = append(drifts, Drift{Path: path, Change: DriftLocalOnly, Local: localValue})
+		case !inLocal:
+			drifts = append(drifts, Drift{Path: path, Change: DriftClusterOnly, Cluster: clusterValue})
+		case localValue != clusterValue:
+			drifts = append(drifts, Drift{Path: path, Change: DriftChanged, Local: localValue, Cluster: clusterValue})
+		}
+	}
+	return drifts, nil
+}
+
+// Flatten returns the values of the template definition keyed by their dotted path, the settings are prefixed by 'index.'.
+// The lists are kept as JSON, the empty objects and the nulls are skipped.
+func Flatten(data json.RawMessage) (map[string]string, error) {
+	if len(data) == 0 {
+		return map[string]string{}, nil
+	}
+	var root any
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	values := gu.FlattenValues(root)
+	for path, value := range values {
+		if setting, ok := strings.CutPrefix(path, settingsPath); ok && !strings.HasPrefix(setting, "index.") {
+			delete(values, path)
+			values[settingsPath+"index."+setting] = value
+		}
+	}
+	return values, nil
+}
+
+// FlatSettings returns the settings keyed by their dotted name with the 'index.' prefix.
+func FlatSettings(settings json.RawMessage) (map[string]string, error) {
+	values, err := Flatten(settings)
+	if err != nil {
+		return nil, err
+	}
+	flat := make(map[string]string, len(values))
+	for key, value := range values {
+		if !strings.HasPrefix(key, "index.") {
+			key = "index." + key
+		}
+		flat[key] = value
+	}
+	return flat, nil
+}
@@ -0,0 +1,80 @@
+package templates
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	cluster := json.RawMessage(`{
+		"index_patterns": ["logs-*"],
+		"priority": 10,
+		"template": {
+			"settings": {"index": {"number_of_shards": "2", "refresh_interval": "5s"}},
+			"mappings": {"properties": {"level": {"type": "keyword"}}}
+		}
+	}`)
+	tests := []struct {
+		name     string
+		local    string
+		cluster  json.RawMessage
+		expected Drifts
+	}{
+		{
+			name: "equal with different settings notation",
+			local: `{
+				"index_patterns": ["logs-*"],
+				"priority": 10,
+				"template": {
+					"settings": {"number_of_shards": 2, "index.refresh_interval": "5s"},
+					"mappings": {"properties": {"level": {"type": "keyword"}}},
+					"aliases": {}
+				}
+			}`,
+			cluster: cluster,
+		},
+		{
+			name: "drift",
+			local: `{
+				"index_patterns": ["logs-*", "app-*"],
+				"priority": 10,
+				"template": {
+					"settings": {"index": {"number_of_shards": 2, "number_of_replicas": 1}},
+					"mappings": {"properties": {"level": {"type": "text"}}}
+				}
+			}`,
+			cluster: cluster,
+			expected: Drifts{
+				{Path: "index_patterns", Change: DriftChanged, Local: `["logs-*","app-*"]`, Cluster: `["logs-*"]`},
+				{Path: "template.mappings.properties.level.type", Change: DriftChanged, Local: "text", Cluster: "keyword"},
+				{Path: "template.settings.index.number_of_replicas", Change: DriftLocalOnly, Local: "1"},
+				{Path: "template.settings.index.refresh_interval", Change: DriftClusterOnly, Cluster: "5s"},
+			},
+		},
+		{
+			name:  "missing in the cluster",
+			local: `{"index_patterns": ["logs-*"]}`,
+			expected: Drifts{
+				{Path: "index_patterns", Change: DriftLocalOnly, Local: `["logs-*"]`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drifts, err := Diff(json.RawMessage(tt.local), tt.cluster)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, drifts)
+		})
+	}
+}
+
+func TestFlatSettings(t *testing.T) {
+	settings, err := FlatSettings(json.RawMessage(`{"index":{"number_of_shards":"1","analysis":{"analyzer":{"a":{"type":"custom"}}}},"codec":"best_compression"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"index.number_of_shards":         "1",
+		"index.analysis.analyzer.a.type": "custom",
+		"index.codec":                    "best_compression",
+	}, settings)
+}
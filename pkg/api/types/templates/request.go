@@ -0,0 +1,39 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Kind is the kind of the template.
+type Kind string
+
+const (
+	KindIndex     Kind = "index"
+	KindComponent Kind = "component"
+)
+
+// Definition returns the template definition from the body of the put request, or from the get response
+// with the single template, in the latter case the template name is returned as well.
+func Definition(kind Kind, data []byte) (string, json.RawMessage, error) {
+	var response struct {
+		IndexTemplates     IndexTemplates     `json:"index_templates"`
+		ComponentTemplates ComponentTemplates `json:"component_templates"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return "", nil, fmt.Errorf("template is not a valid JSON:%w", err)
+	}
+	switch {
+	case kind == KindIndex && response.IndexTemplates != nil:
+		if len(response.IndexTemplates) != 1 {
+			return "", nil, fmt.Errorf("expected the single index template, got %d", len(response.IndexTemplates))
+		}
+		return response.IndexTemplates[0].Name, response.IndexTemplates[0].IndexTemplate, nil
+	case kind == KindComponent && response.ComponentTemplates != nil:
+		if len(response.ComponentTemplates) != 1 {
+			return "", nil, fmt.Errorf("expected the single component template, got %d", len(response.ComponentTemplates))
+		}
+		return response.ComponentTemplates[0].Name, response.ComponentTemplates[0].ComponentTemplate, nil
+	}
+	return "", data, nil
+}
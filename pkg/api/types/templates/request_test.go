@@ -0,0 +1,62 @@
+package templates
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDefinition(t *testing.T) {
+	tests := []struct {
+		name               string
+		kind               Kind
+		data               string
+		expectedName       string
+		expectedDefinition string
+		wantErr            bool
+	}{
+		{
+			name:               "plain definition",
+			kind:               KindIndex,
+			data:               `{"index_patterns":["logs-*"],"priority":1}`,
+			expectedDefinition: `{"index_patterns":["logs-*"],"priority":1}`,
+		},
+		{
+			name:               "index template get response",
+			kind:               KindIndex,
+			data:               `{"index_templates":[{"name":"logs","index_template":{"index_patterns":["logs-*"]}}]}`,
+			expectedName:       "logs",
+			expectedDefinition: `{"index_patterns":["logs-*"]}`,
+		},
+		{
+			name:               "component template get response",
+			kind:               KindComponent,
+			data:               `{"component_templates":[{"name":"base","component_template":{"template":{}}}]}`,
+			expectedName:       "base",
+			expectedDefinition: `{"template":{}}`,
+		},
+		{
+			name:    "get response with several templates",
+			kind:    KindIndex,
+			data:    `{"index_templates":[{"name":"a","index_template":{}},{"name":"b","index_template":{}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			kind:    KindComponent,
+			data:    `{"template":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, definition, err := Definition(tt.kind, []byte(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedName, name)
+			assert.JSONEq(t, tt.expectedDefinition, string(definition))
+		})
+	}
+}
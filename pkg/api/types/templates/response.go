@@ -0,0 +1,145 @@
+package templates
+
+import (
+	"encoding/json"
+	"github.com/dalet-oss/opensearch-cli/pkg/api/types/indices"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// IndexTemplate is the composable index template.
+type IndexTemplate struct {
+	Name          string          `json:"name"`
+	IndexTemplate json.RawMessage `json:"index_template"`
+}
+
+// IndexTemplates is the list of index templates, it is rendered as a table.
+type IndexTemplates []IndexTemplate
+
+// TableHeader returns the column names of the index templates table.
+func (t IndexTemplates) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"name", "index_patterns", "priority", "composed_of", "version", "data_stream", "settings", "fields"}
+	}
+	return []string{"name", "index_patterns", "priority", "composed_of"}
+}
+
+// TableRows returns a row per index template.
+func (t IndexTemplates) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(t))
+	for _, template := range t {
+		var d definition
+		_ = json.Unmarshal(template.IndexTemplate, &d)
+		row := []string{template.Name, strings.Join(d.IndexPatterns, ","), optional(d.Priority), strings.Join(d.ComposedOf, ",")}
+		if wide {
+			row = append(row, optional(d.Version), strconv.FormatBool(d.DataStream != nil), d.Template.settings(), d.Template.fields())
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ComponentTemplate is the reusable block of the settings, mappings and aliases the index templates are composed of.
+type ComponentTemplate struct {
+	Name              string          `json:"name"`
+	ComponentTemplate json.RawMessage `json:"component_template"`
+}
+
+// ComponentTemplates is the list of component templates, it is rendered as a table.
+type ComponentTemplates []ComponentTemplate
+
+// TableHeader returns the column names of the component templates table.
+func (t ComponentTemplates) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"name", "version", "settings", "fields", "aliases"}
+	}
+	return []string{"name", "version", "settings", "fields"}
+}
+
+// TableRows returns a row per component template, the settings and the mapped fields are counted.
+func (t ComponentTemplates) TableRows(wide bool) [][]string {
+	rows := make([][]string, 0, len(t))
+	for _, template := range t {
+		var d definition
+		_ = json.Unmarshal(template.ComponentTemplate, &d)
+		row := []string{template.Name, optional(d.Version), d.Template.settings(), d.Template.fields()}
+		if wide {
+			row = append(row, strings.Join(slices.Sorted(maps.Keys(d.Template.Aliases)), ","))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// definition is the part of the template definition shown in the tables.
+type definition struct {
+	IndexPatterns []string        `json:"index_patterns"`
+	ComposedOf    []string        `json:"composed_of"`
+	Priority      *int            `json:"priority"`
+	Version       *int            `json:"version"`
+	DataStream    json.RawMessage `json:"data_stream"`
+	Template      Template        `json:"template"`
+}
+
+// Template holds the settings, mappings and aliases applied to the new index.
+type Template struct {
+	Settings json.RawMessage            `json:"settings,omitempty"`
+	Mappings json.RawMessage            `json:"mappings,omitempty"`
+	Aliases  map[string]json.RawMessage `json:"aliases,omitempty"`
+}
+
+// settings returns the number of the settings.
+func (t Template) settings() string {
+	flat, _ := FlatSettings(t.Settings)
+	return strconv.Itoa(len(flat))
+}
+
+// fields returns the number of the mapped fields.
+func (t Template) fields() string {
+	fields, _ := indices.MappingFields(t.Mappings)
+	return strconv.Itoa(len(fields))
+}
+
+// optional returns the string representation of the number, or '-' if it is not set.
+func optional(n *int) string {
+	if n == nil {
+		return "-"
+	}
+	return strconv.Itoa(*n)
+}
+
+// Overlapping is the template matching the index with the lower priority than the applied one.
+type Overlapping struct {
+	Name          string   `json:"name"`
+	IndexPatterns []string `json:"index_patterns"`
+}
+
+// Simulation is the result of the index template simulation: the effective template the new index would get.
+type Simulation struct {
+	Template    Template      `json:"template"`
+	Overlapping []Overlapping `json:"overlapping,omitempty"`
+}
+
+// TableHeader returns the column names of the simulation table.
+func (s Simulation) TableHeader(_ bool) []string {
+	return []string{"kind", "name", "value"}
+}
+
+// TableRows returns a row per setting, mapped field and alias of the effective template.
+func (s Simulation) TableRows(_ bool) [][]string {
+	var rows [][]string
+	settings, _ := FlatSettings(s.Template.Settings)
+	for _, key := range slices.Sorted(maps.Keys(settings)) {
+		rows = append(rows, []string{"setting", key, settings[key]})
+	}
+	fields, _ := indices.MappingFields(s.Template.Mappings)
+	for _, f := range fields {
+		rows = append(rows, []string{"field", f.Field, f.Type})
+	}
+	for _, alias := range slices.Sorted(maps.Keys(s.Template.Aliases)) {
+		rows = append(rows, []string{"alias", alias, ""})
+	}
+	return rows
+}
@@ -1,5 +1,14 @@
 package appconfig
 
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"slices"
+)
+
 // ClusterConfig represents the configuration for managing a single cluster.
 type ClusterConfig struct {
 	// Name holds the cluster name.
@@ -14,6 +23,98 @@ type ClusterParams struct {
 	Server string `yaml:"server"`
 	// SkipTLSVerify indicates whether TLS encryption is enabled for the cluster connection.
 	SkipTLSVerify bool `yaml:"skipTLSVerify"`
+	// Servers holds the additional node addresses of the cluster, the requests are balanced between Server and Servers.
+	Servers []string `yaml:"servers,omitempty"`
+	// DiscoverNodesOnStart enables discovery of the cluster nodes when the client is initialized.
+	DiscoverNodesOnStart bool `yaml:"discoverNodesOnStart,omitempty"`
+	// DiscoverNodesOnFailure enables discovery of the cluster nodes when one of the known nodes fails to answer.
+	DiscoverNodesOnFailure bool `yaml:"discoverNodesOnFailure,omitempty"`
+	// RetryOnStatus holds the response status codes which are retried on the next node(default: 502, 503, 504).
+	RetryOnStatus []int `yaml:"retryOnStatus,omitempty"`
+	// MaxRetries holds the maximum number of retries of the single request(default: 3).
+	MaxRetries int `yaml:"maxRetries,omitempty"`
 	// ProxyUrl holds the proxy URL for the cluster connection.
 	ProxyUrl string `yaml:"proxy-url,omitempty"`
+	// CertificateAuthority holds the path to the PEM encoded CA certificate used to verify the server certificate.
+	CertificateAuthority string `yaml:"certificateAuthority,omitempty"`
+	// CertificateAuthorityData holds the base64 encoded PEM CA certificate, takes precedence over CertificateAuthority.
+	CertificateAuthorityData string `yaml:"certificateAuthorityData,omitempty"`
+	// ClientCertificate holds the path to the PEM encoded client certificate used for mTLS authentication.
+	ClientCertificate string `yaml:"clientCertificate,omitempty"`
+	// ClientCertificateData holds the base64 encoded PEM client certificate, takes precedence over ClientCertificate.
+	ClientCertificateData string `yaml:"clientCertificateData,omitempty"`
+	// ClientKey holds the path to the PEM encoded private key of the client certificate.
+	ClientKey string `yaml:"clientKey,omitempty"`
+	// ClientKeyData holds the base64 encoded PEM private key of the client certificate, takes precedence over ClientKey.
+	ClientKeyData string `yaml:"clientKeyData,omitempty"`
+	// TLSServerName overrides the server name used to verify the server certificate.
+	TLSServerName string `yaml:"tlsServerName,omitempty"`
+}
+
+// Addresses returns the deduplicated list of the cluster node addresses, Server goes first.
+func (p ClusterParams) Addresses() []string {
+	addresses := []string{}
+	for _, address := range append([]string{p.Server}, p.Servers...) {
+		if address != "" && !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// HasClientCertificate returns true if the client certificate for mTLS authentication is configured.
+func (p ClusterParams) HasClientCertificate() bool {
+	return p.ClientCertificate != "" || p.ClientCertificateData != ""
+}
+
+// TLSConfig builds the TLS configuration of the cluster connection from the CA, client certificate and server name parameters.
+func (p ClusterParams) TLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: p.SkipTLSVerify,
+		ServerName:         p.TLSServerName,
+	}
+	caPem, err := loadPem(p.CertificateAuthority, p.CertificateAuthorityData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load certificate authority:%w", err)
+	}
+	if caPem != nil {
+		pool, poolErr := x509.SystemCertPool()
+		if poolErr != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("certificate authority doesn't contain valid PEM certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	certPem, err := loadPem(p.ClientCertificate, p.ClientCertificateData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load client certificate:%w", err)
+	}
+	keyPem, err := loadPem(p.ClientKey, p.ClientKeyData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load client key:%w", err)
+	}
+	if (certPem == nil) != (keyPem == nil) {
+		return nil, fmt.Errorf("client certificate and client key must be provided together")
+	}
+	if certPem != nil {
+		cert, keyPairErr := tls.X509KeyPair(certPem, keyPem)
+		if keyPairErr != nil {
+			return nil, fmt.Errorf("invalid client certificate or key:%w", keyPairErr)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// loadPem returns the PEM content from the base64 encoded data or from the file, nil if neither is set.
+func loadPem(file, data string) ([]byte, error) {
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	if file != "" {
+		return generic.ReadFile(file)
+	}
+	return nil, nil
 }
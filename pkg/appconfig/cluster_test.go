@@ -0,0 +1,152 @@
+package appconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// generateCert generates the self-signed certificate and returns the PEM encoded certificate and key.
+func generateCert(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "opensearch-cli-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+// TestClusterParamsTLSConfig validates building of the TLS configuration from the file and inline parameters.
+func TestClusterParamsTLSConfig(t *testing.T) {
+	certPem, keyPem := generateCert(t)
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	assert.NoError(t, os.WriteFile(certFile, certPem, 0600))
+	assert.NoError(t, os.WriteFile(keyFile, keyPem, 0600))
+	certData := base64.StdEncoding.EncodeToString(certPem)
+	keyData := base64.StdEncoding.EncodeToString(keyPem)
+
+	tests := []struct {
+		name             string
+		params           ClusterParams
+		wantErr          bool
+		wantRootCAs      bool
+		wantCertificates int
+	}{
+		{
+			name:   "no tls params",
+			params: ClusterParams{SkipTLSVerify: true},
+		},
+		{
+			name:        "ca from file",
+			params:      ClusterParams{CertificateAuthority: certFile, TLSServerName: "node-1"},
+			wantRootCAs: true,
+		},
+		{
+			name:        "inline ca",
+			params:      ClusterParams{CertificateAuthorityData: certData},
+			wantRootCAs: true,
+		},
+		{
+			name:             "client certificate from files",
+			params:           ClusterParams{ClientCertificate: certFile, ClientKey: keyFile},
+			wantCertificates: 1,
+		},
+		{
+			name:             "inline client certificate",
+			params:           ClusterParams{CertificateAuthorityData: certData, ClientCertificateData: certData, ClientKeyData: keyData},
+			wantRootCAs:      true,
+			wantCertificates: 1,
+		},
+		{
+			name:    "client certificate without key",
+			params:  ClusterParams{ClientCertificate: certFile},
+			wantErr: true,
+		},
+		{
+			name:    "key mismatch",
+			params:  ClusterParams{ClientCertificate: certFile, ClientKeyData: base64.StdEncoding.EncodeToString(certPem)},
+			wantErr: true,
+		},
+		{
+			name:    "missing ca file",
+			params:  ClusterParams{CertificateAuthority: filepath.Join(dir, "missing.pem")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ca data",
+			params:  ClusterParams{CertificateAuthorityData: base64.StdEncoding.EncodeToString([]byte("not a certificate"))},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlsConfig, err := tt.params.TLSConfig()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.params.SkipTLSVerify, tlsConfig.InsecureSkipVerify)
+			assert.Equal(t, tt.params.TLSServerName, tlsConfig.ServerName)
+			assert.Equal(t, tt.wantRootCAs, tlsConfig.RootCAs != nil)
+			assert.Len(t, tlsConfig.Certificates, tt.wantCertificates)
+		})
+	}
+}
+
+// TestClusterParamsAddresses validates merging of the server and additional servers.
+func TestClusterParamsAddresses(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   ClusterParams
+		expected []string
+	}{
+		{
+			name:     "single server",
+			params:   ClusterParams{Server: "https://node-1:9200"},
+			expected: []string{"https://node-1:9200"},
+		},
+		{
+			name:     "server and servers",
+			params:   ClusterParams{Server: "https://node-1:9200", Servers: []string{"https://node-2:9200", "https://node-3:9200"}},
+			expected: []string{"https://node-1:9200", "https://node-2:9200", "https://node-3:9200"},
+		},
+		{
+			name:     "duplicates and empty entries are dropped",
+			params:   ClusterParams{Server: "https://node-1:9200", Servers: []string{"", "https://node-1:9200", "https://node-2:9200"}},
+			expected: []string{"https://node-1:9200", "https://node-2:9200"},
+		},
+		{
+			name:     "servers only",
+			params:   ClusterParams{Servers: []string{"https://node-2:9200"}},
+			expected: []string{"https://node-2:9200"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.params.Addresses())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package appconfig
 import (
 	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
 	"slices"
 	"strings"
 	"time"
@@ -42,6 +43,8 @@ type CliParams struct {
 	ServerTimeoutSeconds *int `yaml:"serverTimeoutSeconds"`
 	// DebugLogs specifies whether to enable debug logging from the opensearch-go library.
 	DebugLogs *bool `yaml:"debugLogs"`
+	// ProtectedIndices holds the index patterns 'index delete' refuses to delete or close, see generic.GetMatchFunc.
+	ProtectedIndices []string `yaml:"protectedIndices,omitempty"`
 }
 
 // GetServerTimeoutSeconds returns the server timeout in seconds, using a default value if none is configured.
@@ -59,6 +62,19 @@ func (p *CliParams) EnableDebugLogs() bool {
 	return *p.DebugLogs
 }
 
+// ProtectedBy returns the protected indices pattern matching the index, empty if the index is not protected.
+func (c *AppConfig) ProtectedBy(index string) string {
+	if c.CliParams == nil {
+		return ""
+	}
+	for _, pattern := range c.CliParams.ProtectedIndices {
+		if generic.GetMatchFunc(pattern)(index) {
+			return pattern
+		}
+	}
+	return ""
+}
+
 // ShowContextInfo returns a formatted string with context details, including cluster and user information.
 // If the context, cluster, or user does not exist, appropriate error indicators are included in the output.
 func (c *AppConfig) ShowContextInfo(name string) string {
@@ -91,13 +107,40 @@ func (c *AppConfig) ShowContextInfoExtended(name string) string {
 		if cluster := c.GetCluster(ctx.Cluster); cluster != nil {
 			info = append(info, fmt.Sprintf("	Cluster: %s", cluster.Name))
 			info = append(info, fmt.Sprintf("		Server: %s", cluster.Params.Server))
+			if len(cluster.Params.Servers) > 0 {
+				info = append(info, fmt.Sprintf("		Servers: %s", strings.Join(cluster.Params.Servers, ", ")))
+			}
+			if cluster.Params.DiscoverNodesOnStart || cluster.Params.DiscoverNodesOnFailure {
+				info = append(info, fmt.Sprintf("		Discover nodes: on start=%t, on failure=%t", cluster.Params.DiscoverNodesOnStart, cluster.Params.DiscoverNodesOnFailure))
+			}
+			if len(cluster.Params.RetryOnStatus) > 0 || cluster.Params.MaxRetries > 0 {
+				info = append(info, fmt.Sprintf("		Retries: max=%d, on status=%v", cluster.Params.MaxRetries, cluster.Params.RetryOnStatus))
+			}
 			info = append(info, fmt.Sprintf("		TLS: %t", cluster.Params.SkipTLSVerify))
+			if cluster.Params.CertificateAuthority != "" || cluster.Params.CertificateAuthorityData != "" {
+				info = append(info, fmt.Sprintf("		CA: %s", fp.Ternary(cluster.Params.CertificateAuthority, "<embedded>", cluster.Params.CertificateAuthorityData == "")))
+			}
+			if cluster.Params.HasClientCertificate() {
+				info = append(info, fmt.Sprintf("		Client certificate: %s", fp.Ternary(cluster.Params.ClientCertificate, "<embedded>", cluster.Params.ClientCertificateData == "")))
+			}
+			if cluster.Params.TLSServerName != "" {
+				info = append(info, fmt.Sprintf("		TLS server name: %s", cluster.Params.TLSServerName))
+			}
 		} else {
 			info = append(info, "	❌cluster is not found")
 		}
 		if user := c.GetUser(ctx.User); user != nil {
 			info = append(info, fmt.Sprintf("	User: %s", user.Name))
 			info = append(info, fmt.Sprintf("		Token: %s", user.User.Token))
+			if user.User.SigV4 != nil {
+				info = append(info, fmt.Sprintf("		SigV4: region=%s, service=%s", user.User.SigV4.Region, fp.Ternary(user.User.SigV4.Service, "es", user.User.SigV4.Service != "")))
+				if user.User.SigV4.RoleARN != "" {
+					info = append(info, fmt.Sprintf("		Role: %s", user.User.SigV4.RoleARN))
+				}
+			}
+			if user.User.Exec != nil {
+				info = append(info, fmt.Sprintf("		Exec: %s", strings.Join(append([]string{user.User.Exec.Cmd}, user.User.Exec.Args...), " ")))
+			}
 		} else {
 			info = append(info, fmt.Sprintf("	❌User is not found: %s", ctx.User))
 		}
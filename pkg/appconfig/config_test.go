@@ -0,0 +1,15 @@
+package appconfig
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAppConfig_ProtectedBy(t *testing.T) {
+	c := AppConfig{CliParams: &CliParams{ProtectedIndices: []string{".kibana*", "orders", "*-archive"}}}
+	assert.Equal(t, ".kibana*", c.ProtectedBy(".kibana_1"))
+	assert.Equal(t, "orders", c.ProtectedBy("orders"))
+	assert.Equal(t, "*-archive", c.ProtectedBy("logs-archive"))
+	assert.Equal(t, "", c.ProtectedBy("orders-v2"))
+	assert.Equal(t, "", (&AppConfig{}).ProtectedBy("orders"))
+}
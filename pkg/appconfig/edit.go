@@ -0,0 +1,118 @@
+package appconfig
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/creds"
+	"slices"
+	"strings"
+)
+
+// PruneResult holds the entries removed from the config by Prune.
+type PruneResult struct {
+	// Clusters holds the names of the removed clusters.
+	Clusters []string
+	// Users holds the removed users, their keyring entries must be removed by the caller.
+	Users []UserConfig
+}
+
+// IsEmpty returns true if nothing was removed.
+func (r PruneResult) IsEmpty() bool {
+	return len(r.Clusters) == 0 && len(r.Users) == 0
+}
+
+// KeyringIds returns the ids of the keyring entries referenced by the user.
+func (u *UserConfig) KeyringIds() []string {
+	keyringIdPrefix := creds.KeyringPrefix + creds.KeyringSeparator
+	ids := []string{}
+	if strings.HasPrefix(u.User.Token, keyringIdPrefix) {
+		ids = append(ids, u.User.Token)
+	}
+	if u.User.SigV4 != nil && strings.HasPrefix(u.User.SigV4.Token, keyringIdPrefix) {
+		ids = append(ids, u.User.SigV4.Token)
+	}
+	return ids
+}
+
+// DeleteContext removes the context from the config, the current context is reset if it is the removed one.
+// The cluster and user of the context are kept, use Prune to remove the ones which are not referenced anymore.
+func (c *AppConfig) DeleteContext(name string) error {
+	if !c.HasContext(ContextConfig{Name: name}) {
+		return fmt.Errorf("context '%s' is not found", name)
+	}
+	c.Contexts = slices.DeleteFunc(c.Contexts, func(p ContextConfig) bool {
+		return p.Name == name
+	})
+	if c.Current == name {
+		c.Current = ""
+	}
+	return nil
+}
+
+// RenameContext changes the name of the context, the current context follows the renamed one.
+func (c *AppConfig) RenameContext(name, newName string) error {
+	ctx := c.GetContext(name)
+	if ctx == nil {
+		return fmt.Errorf("context '%s' is not found", name)
+	}
+	if newName == "" {
+		return fmt.Errorf("new context name is required")
+	}
+	if name != newName && c.HasContext(ContextConfig{Name: newName}) {
+		return fmt.Errorf("context '%s' already exists", newName)
+	}
+	ctx.Name = newName
+	if c.Current == name {
+		c.Current = newName
+	}
+	return nil
+}
+
+// SetContextCluster points the context to the existing cluster.
+func (c *AppConfig) SetContextCluster(name, cluster string) error {
+	ctx := c.GetContext(name)
+	if ctx == nil {
+		return fmt.Errorf("context '%s' is not found", name)
+	}
+	if !c.HasCluster(ClusterConfig{Name: cluster}) {
+		return fmt.Errorf("cluster '%s' is not found", cluster)
+	}
+	ctx.Cluster = cluster
+	return nil
+}
+
+// SetContextUser points the context to the existing user.
+func (c *AppConfig) SetContextUser(name, user string) error {
+	ctx := c.GetContext(name)
+	if ctx == nil {
+		return fmt.Errorf("context '%s' is not found", name)
+	}
+	if !c.HasUser(UserConfig{Name: user}) {
+		return fmt.Errorf("user '%s' is not found", user)
+	}
+	ctx.User = user
+	return nil
+}
+
+// Prune removes the clusters and users which are not referenced by any context and returns the removed entries.
+func (c *AppConfig) Prune() PruneResult {
+	result := PruneResult{}
+	c.Clusters = slices.DeleteFunc(c.Clusters, func(cluster ClusterConfig) bool {
+		referenced := slices.ContainsFunc(c.Contexts, func(ctx ContextConfig) bool {
+			return ctx.Cluster == cluster.Name
+		})
+		if !referenced {
+			result.Clusters = append(result.Clusters, cluster.Name)
+		}
+		return !referenced
+	})
+	c.Users = slices.DeleteFunc(c.Users, func(user UserConfig) bool {
+		referenced := slices.ContainsFunc(c.Contexts, func(ctx ContextConfig) bool {
+			return ctx.User == user.Name
+		})
+		if !referenced {
+			result.Users = append(result.Users, user)
+		}
+		return !referenced
+	})
+	return result
+}
@@ -0,0 +1,166 @@
+package appconfig
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// testConfig returns the config with two contexts sharing the cluster, an unused cluster and an unused user.
+func testConfig() AppConfig {
+	return AppConfig{
+		ApiVersion: ApiVersionV1,
+		Clusters: []ClusterConfig{
+			{Name: "prod", Params: ClusterParams{Server: "https://prod:9200"}},
+			{Name: "dr", Params: ClusterParams{Server: "https://dr:9200"}},
+			{Name: "old", Params: ClusterParams{Server: "https://old:9200"}},
+		},
+		Users: []UserConfig{
+			{Name: "admin", User: User{Token: "kr:::admin"}},
+			{Name: "reader", User: User{Token: "kr:::reader"}},
+			{Name: "legacy", User: User{Token: "kr:::legacy", SigV4: &SigV4Config{Region: "eu-west-1", Token: "kr:::legacy-keys"}}},
+			{Name: "vault", User: User{Vault: &VaultConfig{File: "vault.yml"}}},
+		},
+		Contexts: []ContextConfig{
+			{Name: "admin@prod", Cluster: "prod", User: "admin"},
+			{Name: "reader@prod", Cluster: "prod", User: "reader"},
+			{Name: "admin@dr", Cluster: "dr", User: "admin"},
+		},
+		Current: "admin@prod",
+	}
+}
+
+func TestAppConfig_DeleteContext(t *testing.T) {
+	tests := []struct {
+		name            string
+		context         string
+		wantErr         bool
+		expectedCurrent string
+		expectedLeft    []string
+	}{
+		{
+			name:            "delete current context",
+			context:         "admin@prod",
+			expectedCurrent: "",
+			expectedLeft:    []string{"reader@prod", "admin@dr"},
+		},
+		{
+			name:            "delete other context",
+			context:         "admin@dr",
+			expectedCurrent: "admin@prod",
+			expectedLeft:    []string{"admin@prod", "reader@prod"},
+		},
+		{
+			name:            "context not found",
+			context:         "missing",
+			wantErr:         true,
+			expectedCurrent: "admin@prod",
+			expectedLeft:    []string{"admin@prod", "reader@prod", "admin@dr"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testConfig()
+			err := config.DeleteContext(tt.context)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCurrent, config.Current)
+			assert.Equal(t, tt.expectedLeft, config.GetContextList())
+			assert.Len(t, config.Clusters, 3, "clusters must be kept")
+			assert.Len(t, config.Users, 4, "users must be kept")
+		})
+	}
+}
+
+func TestAppConfig_RenameContext(t *testing.T) {
+	tests := []struct {
+		name            string
+		from            string
+		to              string
+		wantErr         bool
+		expectedCurrent string
+	}{
+		{
+			name:            "rename current context",
+			from:            "admin@prod",
+			to:              "production",
+			expectedCurrent: "production",
+		},
+		{
+			name:            "rename other context",
+			from:            "admin@dr",
+			to:              "disaster-recovery",
+			expectedCurrent: "admin@prod",
+		},
+		{
+			name:            "new name is taken",
+			from:            "admin@dr",
+			to:              "reader@prod",
+			wantErr:         true,
+			expectedCurrent: "admin@prod",
+		},
+		{
+			name:            "empty new name",
+			from:            "admin@dr",
+			to:              "",
+			wantErr:         true,
+			expectedCurrent: "admin@prod",
+		},
+		{
+			name:            "context not found",
+			from:            "missing",
+			to:              "new",
+			wantErr:         true,
+			expectedCurrent: "admin@prod",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testConfig()
+			err := config.RenameContext(tt.from, tt.to)
+			assert.Equal(t, tt.expectedCurrent, config.Current)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, config.HasContext(ContextConfig{Name: tt.to}))
+			assert.False(t, config.HasContext(ContextConfig{Name: tt.from}))
+		})
+	}
+}
+
+func TestAppConfig_SetContextClusterAndUser(t *testing.T) {
+	config := testConfig()
+	assert.NoError(t, config.SetContextCluster("admin@dr", "old"))
+	assert.Equal(t, "old", config.GetContext("admin@dr").Cluster)
+	assert.Error(t, config.SetContextCluster("admin@dr", "missing"), "cluster must exist")
+	assert.Error(t, config.SetContextCluster("missing", "prod"), "context must exist")
+	assert.Equal(t, "old", config.GetContext("admin@dr").Cluster)
+
+	assert.NoError(t, config.SetContextUser("admin@dr", "vault"))
+	assert.Equal(t, "vault", config.GetContext("admin@dr").User)
+	assert.Error(t, config.SetContextUser("admin@dr", "missing"), "user must exist")
+	assert.Error(t, config.SetContextUser("missing", "admin"), "context must exist")
+	assert.Equal(t, "vault", config.GetContext("admin@dr").User)
+}
+
+func TestAppConfig_Prune(t *testing.T) {
+	config := testConfig()
+	pruned := config.Prune()
+	assert.Equal(t, []string{"old"}, pruned.Clusters)
+	assert.Equal(t, []string{"legacy", "vault"}, []string{pruned.Users[0].Name, pruned.Users[1].Name})
+	assert.Equal(t, []string{"kr:::legacy", "kr:::legacy-keys"}, pruned.Users[0].KeyringIds())
+	assert.Empty(t, pruned.Users[1].KeyringIds(), "vault user has no keyring entries")
+	assert.Len(t, config.Clusters, 2)
+	assert.Len(t, config.Users, 2)
+
+	assert.True(t, config.Prune().IsEmpty(), "second prune must be a no-op")
+
+	assert.NoError(t, config.DeleteContext("admin@dr"))
+	pruned = config.Prune()
+	assert.Equal(t, []string{"dr"}, pruned.Clusters)
+	assert.Empty(t, pruned.Users, "admin is still used by admin@prod")
+}
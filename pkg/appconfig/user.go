@@ -7,6 +7,7 @@ import (
 	"github.com/dalet-oss/opensearch-cli/pkg/types"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/creds"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"github.com/opensearch-project/opensearch-go/v4/signer"
 )
 
 // UserConfig represents the configuration for managing a single user.
@@ -24,10 +25,13 @@ type User struct {
 	// Token represents a security token used for authentication, serialized as "token" in YAML, and omitted if empty.
 	Token string `yaml:"token,omitempty"`
 	// Exec represents a command to execute for authentication, serialized as "exec" in YAML, and omitted if empty.
-	// not implemented yet
-	Exec CredentialsExec `yaml:"exec,omitempty"`
+	Exec *CredentialsExec `yaml:"exec,omitempty"`
 	// Vault represents a Vault authentication configuration
 	Vault *VaultConfig `yaml:"vault,omitempty"`
+	// Headers holds the custom static headers added to every request, the secret headers belong to the keyring entry.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// SigV4 represents the AWS SigV4 request signing configuration of the Amazon OpenSearch Service domains.
+	SigV4 *SigV4Config `yaml:"sigv4,omitempty"`
 }
 
 type VaultConfig struct {
@@ -41,9 +45,52 @@ type VaultConfig struct {
 	Password string `yaml:"passKey"`
 }
 
+// SigV4Config represents the AWS IAM authentication, the requests are signed instead of sending the basic auth credentials.
+type SigV4Config struct {
+	// Region of the domain.
+	Region string `yaml:"region"`
+	// Service is the signing name, es(Amazon OpenSearch Service, default) or aoss(Amazon OpenSearch Serverless).
+	Service string `yaml:"service,omitempty"`
+	// Profile is the AWS shared config profile, the default AWS credentials chain is used if neither profile nor token is set.
+	Profile string `yaml:"profile,omitempty"`
+	// Token is the keyring id of the static access key id and secret access key.
+	Token string `yaml:"token,omitempty"`
+	// RoleARN is the role assumed with the resolved credentials.
+	RoleARN string `yaml:"roleArn,omitempty"`
+	// RoleSessionName is the session name of the assumed role.
+	RoleSessionName string `yaml:"roleSessionName,omitempty"`
+}
+
+// CredentialsExec represents the credentials helper command.
+// The command must print a JSON document with either 'username' and 'password' or 'token' to stdout,
+// the optional 'expiry'(RFC3339) field enables caching of the credentials until they expire.
 type CredentialsExec struct {
-	Cmd  string        `yaml:"cmd"`
-	Args []interface{} `json:"args"`
+	// Cmd the command to execute.
+	Cmd string `yaml:"cmd"`
+	// Args the arguments passed to the command.
+	Args []string `yaml:"args,omitempty"`
+	// Env the additional environment variables passed to the command.
+	Env map[string]string `yaml:"env,omitempty"`
+}
+
+// HasCredentials returns true if the user has a credential backend configured.
+func (u *UserConfig) HasCredentials() bool {
+	return u.User.Token != "" || u.User.Vault != nil || u.User.Exec != nil || u.User.SigV4 != nil
+}
+
+// GetSigner returns the AWS SigV4 request signer for the sigv4 users, nil for the other users.
+func (u *UserConfig) GetSigner(ctx context.Context) (signer.Signer, error) {
+	if u.User.SigV4 == nil {
+		return nil, nil
+	}
+	return creds.NewSigV4Signer(ctx, creds.SigV4Options{
+		Region:          u.User.SigV4.Region,
+		Service:         u.User.SigV4.Service,
+		Profile:         u.User.SigV4.Profile,
+		StaticKeysToken: u.User.SigV4.Token,
+		RoleARN:         u.User.SigV4.RoleARN,
+		RoleSessionName: u.User.SigV4.RoleSessionName,
+	})
 }
 
 // GetUserCredentials retrieves and decrypts user credentials based on the configuration, such as token or vault settings.
@@ -97,5 +144,21 @@ func (u *UserConfig) GetUserCredentials(ctx context.Context) (*types.Creds, erro
 			}
 		}
 	}
-	return nil, fmt.Errorf("not implemented")
+	if u.User.SigV4 != nil {
+		// the requests are signed by GetSigner, no basic auth credentials are sent
+		u.decryptedCreds = &types.Creds{}
+		return u.decryptedCreds, nil
+	}
+	if u.User.Exec != nil {
+		if u.User.Exec.Cmd == "" {
+			return nil, fmt.Errorf("exec command must be provided")
+		}
+		execCreds, err := creds.GetDataFromExec(u.User.Exec.Cmd, u.User.Exec.Args, u.User.Exec.Env)
+		if err != nil {
+			return nil, err
+		}
+		u.decryptedCreds = execCreds
+		return u.decryptedCreds, nil
+	}
+	return nil, fmt.Errorf("user '%s' has no credential backend configured", u.Name)
 }
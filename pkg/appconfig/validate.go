@@ -0,0 +1,83 @@
+package appconfig
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/creds"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"os"
+)
+
+// Validate checks the config for the problems which otherwise surface only when the context is used:
+// unsupported api version, duplicate names, dangling context references, invalid cluster TLS settings,
+// unreadable vault files and missing keyring entries. Returns the list of found problems, empty if the config is valid.
+func (c *AppConfig) Validate() []error {
+	var problems []error
+	if c.ApiVersion != ApiVersionV1 {
+		problems = append(problems, fmt.Errorf("unsupported apiVersion '%s', expected '%s'", c.ApiVersion, ApiVersionV1))
+	}
+	for _, name := range duplicates(c.Clusters, func(e ClusterConfig) string { return e.Name }) {
+		problems = append(problems, fmt.Errorf("cluster '%s' is defined more than once", name))
+	}
+	for _, name := range duplicates(c.Users, func(e UserConfig) string { return e.Name }) {
+		problems = append(problems, fmt.Errorf("user '%s' is defined more than once", name))
+	}
+	for _, name := range duplicates(c.Contexts, func(e ContextConfig) string { return e.Name }) {
+		problems = append(problems, fmt.Errorf("context '%s' is defined more than once", name))
+	}
+	if c.Current != "" && c.GetContext(c.Current) == nil {
+		problems = append(problems, fmt.Errorf("current context '%s' is not found", c.Current))
+	}
+	for _, ctx := range c.Contexts {
+		if c.GetCluster(ctx.Cluster) == nil {
+			problems = append(problems, fmt.Errorf("context '%s' refers to the missing cluster '%s'", ctx.Name, ctx.Cluster))
+		}
+		if c.GetUser(ctx.User) == nil {
+			problems = append(problems, fmt.Errorf("context '%s' refers to the missing user '%s'", ctx.Name, ctx.User))
+		}
+	}
+	for _, cluster := range c.Clusters {
+		if len(cluster.Params.Addresses()) == 0 {
+			problems = append(problems, fmt.Errorf("cluster '%s' has no server address", cluster.Name))
+		}
+		if _, err := cluster.Params.TLSConfig(); err != nil {
+			problems = append(problems, fmt.Errorf("cluster '%s' has invalid TLS settings:%w", cluster.Name, err))
+		}
+	}
+	for _, user := range c.Users {
+		problems = append(problems, user.validate()...)
+	}
+	return problems
+}
+
+// validate checks that the vault file of the user is readable and the keyring entries of the user exist.
+func (u *UserConfig) validate() []error {
+	var problems []error
+	if u.User.Vault != nil && u.User.Vault.File != "" {
+		if file, err := generic.ExpandHome(u.User.Vault.File); err != nil {
+			problems = append(problems, fmt.Errorf("user '%s' vault file is not readable:%w", u.Name, err))
+		} else if _, err := os.Stat(file); err != nil {
+			problems = append(problems, fmt.Errorf("user '%s' vault file is not readable:%w", u.Name, err))
+		}
+	}
+	for _, id := range u.KeyringIds() {
+		if exists, err := creds.KeyringEntryExists(id); err != nil {
+			problems = append(problems, fmt.Errorf("user '%s' keyring entry '%s' is not accessible:%w", u.Name, id, err))
+		} else if !exists {
+			problems = append(problems, fmt.Errorf("user '%s' keyring entry '%s' is not found", u.Name, id))
+		}
+	}
+	return problems
+}
+
+// duplicates returns the names which are used by more than one entry, in the order of their first appearance.
+func duplicates[T any](entries []T, name func(T) string) []string {
+	seen := map[string]int{}
+	var result []string
+	for _, e := range entries {
+		seen[name(e)]++
+		if seen[name(e)] == 2 {
+			result = append(result, name(e))
+		}
+	}
+	return result
+}
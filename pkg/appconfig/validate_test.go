@@ -0,0 +1,91 @@
+package appconfig
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/types"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/creds"
+	"github.com/stretchr/testify/assert"
+	"github.com/zalando/go-keyring"
+	"testing"
+)
+
+func TestAppConfig_Validate(t *testing.T) {
+	keyring.MockInit()
+	token := creds.PushToKeyring(types.Creds{Username: "admin", Password: "secret"})
+	valid := func() AppConfig {
+		return AppConfig{
+			ApiVersion: ApiVersionV1,
+			Clusters:   []ClusterConfig{{Name: "prod", Params: ClusterParams{Server: "https://prod:9200"}}},
+			Users:      []UserConfig{{Name: "admin", User: User{Token: token}}},
+			Contexts:   []ContextConfig{{Name: "admin@prod", Cluster: "prod", User: "admin"}},
+			Current:    "admin@prod",
+		}
+	}
+	tests := []struct {
+		name     string
+		modify   func(c *AppConfig)
+		expected []string
+	}{
+		{
+			name:   "valid config",
+			modify: func(c *AppConfig) {},
+		},
+		{
+			name:     "unsupported api version",
+			modify:   func(c *AppConfig) { c.ApiVersion = "v2" },
+			expected: []string{"unsupported apiVersion 'v2', expected 'v1'"},
+		},
+		{
+			name: "duplicate names",
+			modify: func(c *AppConfig) {
+				c.Clusters = append(c.Clusters, c.Clusters[0])
+				c.Users = append(c.Users, c.Users[0])
+				c.Contexts = append(c.Contexts, c.Contexts[0])
+			},
+			expected: []string{
+				"cluster 'prod' is defined more than once",
+				"user 'admin' is defined more than once",
+				"context 'admin@prod' is defined more than once",
+			},
+		},
+		{
+			name: "dangling references",
+			modify: func(c *AppConfig) {
+				c.Contexts = append(c.Contexts, ContextConfig{Name: "dr", Cluster: "dr", User: "reader"})
+				c.Current = "missing"
+			},
+			expected: []string{
+				"current context 'missing' is not found",
+				"context 'dr' refers to the missing cluster 'dr'",
+				"context 'dr' refers to the missing user 'reader'",
+			},
+		},
+		{
+			name: "cluster without address",
+			modify: func(c *AppConfig) {
+				c.Clusters[0].Params.Server = ""
+			},
+			expected: []string{"cluster 'prod' has no server address"},
+		},
+		{
+			name: "unreadable vault file and missing keyring entry",
+			modify: func(c *AppConfig) {
+				c.Users[0].User = User{Token: "kr:::missing", Vault: &VaultConfig{File: "/nonexistent/vault.yml"}}
+			},
+			expected: []string{
+				"user 'admin' vault file is not readable:stat /nonexistent/vault.yml: no such file or directory",
+				"user 'admin' keyring entry 'kr:::missing' is not found",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid()
+			tt.modify(&config)
+			var problems []string
+			for _, err := range config.Validate() {
+				problems = append(problems, err.Error())
+			}
+			assert.Equal(t, tt.expected, problems)
+		})
+	}
+}
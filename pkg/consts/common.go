@@ -25,6 +25,8 @@ const (
 	ConfigFlag = "config"
 	// RawFlag signals to print raw API response from the OpenSearch cluster
 	RawFlag = "raw"
+	// OutputFlag selects the output format of the command result
+	OutputFlag = "output"
 	// VersionFlag print version of the cli and exit
 	VersionFlag = "version"
 	// VaultPasswordFlag Supply a vault password file to decrypt the vaulted credentials.
@@ -33,6 +35,15 @@ const (
 	DefaultRemoteClusterAlias = "pyramid-replication"
 	// DebugFlag used to enable debug mode
 	DebugFlag = "debug"
+	// ContextFlag used to override the current context for a single invocation
+	ContextFlag = "context"
+
+	// EnvConfig is the environment variable used to override path to the cli config, the flag takes precedence.
+	EnvConfig = "OSCLI_CONFIG"
+	// EnvContext is the environment variable used to override the current context, the flag takes precedence.
+	EnvContext = "OSCLI_CONTEXT"
+	// EnvRemotePassword is the environment variable with the password of the remote cluster user used by the reindex from remote.
+	EnvRemotePassword = "OSCLI_REMOTE_PASSWORD"
 )
 
 // bootstrapAndGet bootstraps the config dir and returns the path to it.
@@ -1,7 +1,34 @@
 package types
 
+import "net/http"
+
 // Creds represents the credentials for managing a single user.
 type Creds struct {
-	Username string
-	Password string
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// Token is the bearer token(e.g. JWT), used instead of the username and password when set.
+	Token string `json:"token,omitempty"`
+	// APIKey is sent in the 'Authorization: ApiKey <key>' header, used instead of the username and password when set.
+	APIKey string `json:"apiKey,omitempty"`
+	// Headers holds the custom headers added to every request.
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// IsBasic returns true if the credentials are sent with the HTTP basic authentication.
+func (c *Creds) IsBasic() bool {
+	return c.Token == "" && c.APIKey == ""
+}
+
+// Header returns the headers which must be added to every request, including the Authorization header of the token based auth.
+func (c *Creds) Header() http.Header {
+	header := http.Header{}
+	for k, v := range c.Headers {
+		header.Set(k, v)
+	}
+	if c.Token != "" {
+		header.Set("Authorization", "Bearer "+c.Token)
+	} else if c.APIKey != "" {
+		header.Set("Authorization", "ApiKey "+c.APIKey)
+	}
+	return header
 }
@@ -71,11 +71,46 @@ func Init(example bool) {
 	}
 }
 
+// ConfigPath returns the config path set by the config flag or the OSCLI_CONFIG environment variable,
+// empty string means the default config.
+func ConfigPath(cmd *cobra.Command) string {
+	if path := flagutils.GetStringFlag(cmd.Flags(), consts.ConfigFlag); path != "" {
+		return path
+	}
+	return os.Getenv(consts.EnvConfig)
+}
+
+// ContextOverride returns the context set by the context flag or the OSCLI_CONTEXT environment variable,
+// empty string means the current context of the config.
+func ContextOverride(cmd *cobra.Command) string {
+	if cmd.Flags().Lookup(consts.ContextFlag) != nil {
+		if name := flagutils.GetStringFlag(cmd.Flags(), consts.ContextFlag); name != "" {
+			return name
+		}
+	}
+	return os.Getenv(consts.EnvContext)
+}
+
+// LoadConfigForCmd loads the config of the command with the context override applied.
+// The returned config must not be saved, otherwise the override becomes the current context.
+func LoadConfigForCmd(cmd *cobra.Command) appconfig.AppConfig {
+	config := LoadConfig(ConfigPath(cmd))
+	if override := ContextOverride(cmd); override != "" {
+		config.Current = override
+	}
+	return config
+}
+
 // LoadConfig loads the application configuration from the specified path or defaults to the predefined config path.
 // If the file does not exist, it initializes a new configuration file if using the default path.
 // If an error occurs while reading or unmarshalling the file, the function logs a fatal error.
+// The path may be a list of files separated by the OS path list separator, the missing files of the list are skipped
+// and the rest are merged with MergeConfigs.
 // Returns the loaded AppConfig structure.
 func LoadConfig(path string) appconfig.AppConfig {
+	if paths := SplitConfigPaths(path); len(paths) > 1 {
+		return MergeConfigs(loadConfigs(paths)...)
+	}
 	configPath := consts.DefaultConfig()
 	if len(path) > 0 {
 		configPath = path
@@ -85,24 +120,52 @@ func LoadConfig(path string) appconfig.AppConfig {
 	} else if os.IsNotExist(err) {
 		log.Fatal().Msgf("config file not found:%v", err)
 	}
-	if fileContent, err := os.ReadFile(configPath); err != nil {
-		log.Fatal().Msgf("unable to read config file:%v", err)
-	} else {
-		var config appconfig.AppConfig
-		marshalErr := yaml.Unmarshal(fileContent, &config)
-		if marshalErr != nil {
-			log.Fatal().Msgf("unable to unmarshal config file:%v", marshalErr)
+	return readConfig(configPath)
+}
+
+// loadConfigs loads each config of the list, the missing files are returned as empty configs.
+func loadConfigs(paths []string) []appconfig.AppConfig {
+	configs := make([]appconfig.AppConfig, len(paths))
+	for i, configPath := range paths {
+		if _, err := os.Stat(configPath); os.IsNotExist(err) {
+			log.Debug().Msgf("config file '%s' is not found, skipping", configPath)
+			continue
 		}
-		return config
+		configs[i] = readConfig(configPath)
 	}
-	return appconfig.AppConfig{}
+	return configs
+}
+
+// readConfig reads and unmarshals the config file, the program is terminated on failure.
+func readConfig(configPath string) appconfig.AppConfig {
+	fileContent, err := os.ReadFile(configPath)
+	if err != nil {
+		log.Fatal().Msgf("unable to read config file:%v", err)
+	}
+	var config appconfig.AppConfig
+	if marshalErr := yaml.Unmarshal(fileContent, &config); marshalErr != nil {
+		log.Fatal().Msgf("unable to unmarshal config file:%v", marshalErr)
+	}
+	return config
 }
 
 // SaveConfig saves the application configuration to the specified path or defaults to the predefined config path.
 // If the file does not exist, it initializes a new configuration file if using the default path.
 // If an error occurs while writing the file, the function logs a fatal error.
+// If the path is a list of files, the changes are written back to the files defining the entries,
+// see SplitMergedConfig, the missing files except the first one are not created.
 // Returns true if the file was written successfully, false otherwise.
 func SaveConfig(path string, config appconfig.AppConfig) bool {
+	if paths := SplitConfigPaths(path); len(paths) > 1 {
+		saved := true
+		for i, split := range SplitMergedConfig(config, loadConfigs(paths)) {
+			if _, err := os.Stat(paths[i]); os.IsNotExist(err) && i > 0 {
+				continue
+			}
+			saved = writeFileResult(paths[i], configBytes(split)) && saved
+		}
+		return saved
+	}
 	configPath := consts.DefaultConfig()
 	if len(path) > 0 {
 		configPath = path
@@ -110,12 +173,15 @@ func SaveConfig(path string, config appconfig.AppConfig) bool {
 	return writeFileResult(configPath, configBytes(config))
 }
 
-// CreateApiContext returns a context enriched with the vault password flag value if it is set; otherwise, the base context.
+// CreateApiContext returns a context enriched with the vault password flag value if it is set
+// and the context override if it is set, see ContextOverride; otherwise, the base context.
 func CreateApiContext(cmd *cobra.Command) context.Context {
+	ctx := context.WithValue(cmd.Context(), consts.DebugFlag, flagutils.GetBoolFlag(cmd.Flags(), consts.DebugFlag))
 	if cmd.Flags().Lookup(consts.VaultPasswordFlag).Changed {
-		ctx := context.WithValue(cmd.Context(), consts.VaultPasswordFlag, flagutils.GetStringFlag(cmd.Flags(), consts.VaultPasswordFlag))
-		ctx = context.WithValue(ctx, consts.DebugFlag, flagutils.GetBoolFlag(cmd.Flags(), consts.DebugFlag))
-		return ctx
+		ctx = context.WithValue(ctx, consts.VaultPasswordFlag, flagutils.GetStringFlag(cmd.Flags(), consts.VaultPasswordFlag))
+	}
+	if override := ContextOverride(cmd); override != "" {
+		ctx = context.WithValue(ctx, consts.ContextFlag, override)
 	}
-	return context.WithValue(cmd.Context(), consts.DebugFlag, flagutils.GetBoolFlag(cmd.Flags(), consts.DebugFlag))
+	return ctx
 }
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	"path/filepath"
+	"slices"
+)
+
+// SplitConfigPaths splits the list of config files separated by the OS path list separator(':' on unix, ';' on windows).
+func SplitConfigPaths(path string) []string {
+	return slices.DeleteFunc(filepath.SplitList(path), func(p string) bool {
+		return p == ""
+	})
+}
+
+// MergeConfigs merges the configs in the given order, like KUBECONFIG does:
+// the first definition of the cluster, user or context with the same name wins,
+// the current context, api version and cli params are taken from the first config which sets them.
+func MergeConfigs(configs ...appconfig.AppConfig) appconfig.AppConfig {
+	merged := appconfig.AppConfig{}
+	for _, c := range configs {
+		if merged.ApiVersion == "" {
+			merged.ApiVersion = c.ApiVersion
+		}
+		if merged.CliParams == nil {
+			merged.CliParams = c.CliParams
+		}
+		if merged.Current == "" {
+			merged.Current = c.Current
+		}
+		for _, cluster := range c.Clusters {
+			if !merged.HasCluster(cluster) {
+				merged.Clusters = append(merged.Clusters, cluster)
+			}
+		}
+		for _, user := range c.Users {
+			if !merged.HasUser(user) {
+				merged.Users = append(merged.Users, user)
+			}
+		}
+		for _, ctx := range c.Contexts {
+			if !merged.HasContext(ctx) {
+				merged.Contexts = append(merged.Contexts, ctx)
+			}
+		}
+	}
+	return merged
+}
+
+// SplitMergedConfig distributes the changes of the merged config back to the original configs.
+// The entries are updated in the config which defines them and dropped from it if removed, the entries shadowed by
+// the earlier configs are kept untouched, the new entries together with the current context go to the first config.
+func SplitMergedConfig(merged appconfig.AppConfig, originals []appconfig.AppConfig) []appconfig.AppConfig {
+	result := make([]appconfig.AppConfig, len(originals))
+	clusters := fp.Map(originals, func(c appconfig.AppConfig) []appconfig.ClusterConfig { return c.Clusters })
+	users := fp.Map(originals, func(c appconfig.AppConfig) []appconfig.UserConfig { return c.Users })
+	contexts := fp.Map(originals, func(c appconfig.AppConfig) []appconfig.ContextConfig { return c.Contexts })
+	for i, original := range originals {
+		split := original
+		split.Clusters = splitEntries(merged.Clusters, clusters, i, func(e appconfig.ClusterConfig) string { return e.Name })
+		split.Users = splitEntries(merged.Users, users, i, func(e appconfig.UserConfig) string { return e.Name })
+		split.Contexts = splitEntries(merged.Contexts, contexts, i, func(e appconfig.ContextConfig) string { return e.Name })
+		result[i] = split
+	}
+	if len(result) > 0 {
+		result[0].Current = merged.Current
+		if merged.CliParams != nil {
+			result[0].CliParams = merged.CliParams
+		}
+		if result[0].ApiVersion == "" {
+			result[0].ApiVersion = appconfig.ApiVersionV1
+		}
+	}
+	return result
+}
+
+// splitEntries returns the entries of the config idx after the merge: the own entries are replaced with their merged
+// version or dropped if removed, the shadowed ones are kept, the first config receives the entries not defined anywhere.
+func splitEntries[T any](merged []T, originals [][]T, idx int, name func(T) string) []T {
+	definedIn := func(n string) int {
+		return slices.IndexFunc(originals, func(entries []T) bool {
+			return slices.ContainsFunc(entries, func(e T) bool { return name(e) == n })
+		})
+	}
+	var result []T
+	for _, e := range originals[idx] {
+		if definedIn(name(e)) != idx {
+			result = append(result, e)
+			continue
+		}
+		if m := slices.IndexFunc(merged, func(me T) bool { return name(me) == name(e) }); m != -1 {
+			result = append(result, merged[m])
+		}
+	}
+	if idx == 0 {
+		for _, e := range merged {
+			if definedIn(name(e)) == -1 {
+				result = append(result, e)
+			}
+		}
+	}
+	return result
+}
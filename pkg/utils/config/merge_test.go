@@ -0,0 +1,109 @@
+package config
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// teamConfig returns the config shared by the team, it shadows nothing and has no current context.
+func teamConfig() appconfig.AppConfig {
+	return appconfig.AppConfig{
+		ApiVersion: appconfig.ApiVersionV1,
+		Clusters: []appconfig.ClusterConfig{
+			{Name: "prod", Params: appconfig.ClusterParams{Server: "https://team-prod:9200"}},
+			{Name: "dr", Params: appconfig.ClusterParams{Server: "https://team-dr:9200"}},
+		},
+		Users: []appconfig.UserConfig{
+			{Name: "reader", User: appconfig.User{Token: "kr:::reader"}},
+		},
+		Contexts: []appconfig.ContextConfig{
+			{Name: "reader@prod", Cluster: "prod", User: "reader"},
+			{Name: "reader@dr", Cluster: "dr", User: "reader"},
+		},
+	}
+}
+
+// personalConfig returns the personal config, its prod cluster shadows the one of the team config.
+func personalConfig() appconfig.AppConfig {
+	return appconfig.AppConfig{
+		ApiVersion: appconfig.ApiVersionV1,
+		Clusters: []appconfig.ClusterConfig{
+			{Name: "prod", Params: appconfig.ClusterParams{Server: "https://localhost:19200"}},
+		},
+		Users: []appconfig.UserConfig{
+			{Name: "admin", User: appconfig.User{Token: "kr:::admin"}},
+		},
+		Contexts: []appconfig.ContextConfig{
+			{Name: "admin@prod", Cluster: "prod", User: "admin"},
+		},
+		Current: "admin@prod",
+	}
+}
+
+func TestSplitConfigPaths(t *testing.T) {
+	sep := string(os.PathListSeparator)
+	assert.Equal(t, []string{"/a/config"}, SplitConfigPaths("/a/config"))
+	assert.Equal(t, []string{"/a/config", "/b/config"}, SplitConfigPaths("/a/config"+sep+"/b/config"))
+	assert.Equal(t, []string{"/a/config", "/b/config"}, SplitConfigPaths(sep+"/a/config"+sep+sep+"/b/config"+sep))
+	assert.Empty(t, SplitConfigPaths(""))
+}
+
+func TestMergeConfigs(t *testing.T) {
+	merged := MergeConfigs(personalConfig(), teamConfig())
+	assert.Equal(t, "admin@prod", merged.Current)
+	assert.Equal(t, appconfig.ApiVersionV1, merged.ApiVersion)
+	assert.Equal(t, []string{"admin@prod", "reader@prod", "reader@dr"}, merged.GetContextList())
+	assert.Len(t, merged.Clusters, 2)
+	assert.Equal(t, "https://localhost:19200", merged.GetContext("reader@prod").GetCluster(merged).Params.Server,
+		"the first definition of the cluster must win")
+	assert.Len(t, merged.Users, 2)
+
+	merged = MergeConfigs(teamConfig(), personalConfig())
+	assert.Equal(t, "admin@prod", merged.Current, "the current context is taken from the first config which sets it")
+	assert.Equal(t, "https://team-prod:9200", merged.GetContext("admin@prod").GetCluster(merged).Params.Server)
+}
+
+func TestSplitMergedConfig(t *testing.T) {
+	originals := []appconfig.AppConfig{personalConfig(), teamConfig()}
+	merged := MergeConfigs(originals...)
+	// change the entry of the second config, remove the entry of the first one and add a new one
+	assert.NoError(t, merged.RenameContext("reader@dr", "team-dr"))
+	assert.NoError(t, merged.DeleteContext("admin@prod"))
+	merged.Contexts = append(merged.Contexts, appconfig.ContextConfig{Name: "admin@dr", Cluster: "dr", User: "admin"})
+	merged.Current = "admin@dr"
+
+	split := SplitMergedConfig(merged, originals)
+	assert.Len(t, split, 2)
+	assert.Equal(t, "admin@dr", split[0].Current)
+	assert.Equal(t, []string{"team-dr", "admin@dr"}, split[0].GetContextList(), "renamed context is moved to the first config")
+	assert.Equal(t, []string{"reader@prod"}, split[1].GetContextList())
+	assert.Equal(t, "", split[1].Current)
+	assert.Equal(t, "https://localhost:19200", split[0].Clusters[0].Params.Server)
+	assert.Equal(t, "https://team-prod:9200", split[1].Clusters[0].Params.Server, "shadowed cluster must be kept")
+	assert.Equal(t, originals[1].Users, split[1].Users)
+}
+
+func TestLoadAndSaveConfigList(t *testing.T) {
+	dir := t.TempDir()
+	personal, team, missing := filepath.Join(dir, "personal"), filepath.Join(dir, "team"), filepath.Join(dir, "missing")
+	assert.True(t, SaveConfig(personal, personalConfig()))
+	assert.True(t, SaveConfig(team, teamConfig()))
+	path := strings.Join([]string{personal, missing, team}, string(os.PathListSeparator))
+
+	config := LoadConfig(path)
+	assert.Equal(t, []string{"admin@prod", "reader@prod", "reader@dr"}, config.GetContextList())
+	config.Current = "reader@dr"
+	assert.NoError(t, config.SetContextUser("reader@dr", "admin"))
+	assert.True(t, SaveConfig(path, config))
+
+	_, err := os.Stat(missing)
+	assert.True(t, os.IsNotExist(err), "missing config of the list must not be created")
+	assert.Equal(t, "reader@dr", LoadConfig(personal).Current)
+	teamSaved := LoadConfig(team)
+	assert.Equal(t, "admin", teamSaved.GetContext("reader@dr").User)
+	assert.Equal(t, config, LoadConfig(path))
+}
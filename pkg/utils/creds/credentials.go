@@ -1,15 +1,25 @@
 package creds
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	"github.com/dalet-oss/opensearch-cli/pkg/types"
 	"strings"
 )
-import "encoding/base64"
 
-// encodeCreds encodes the user credentials into a base64 encoded string.
-func encodeCreds(username, password string) string {
-	return strings.Join([]string{encodeb64(username), encodeb64(password)}, consts.CredSeparator)
+// encodeCreds encodes the user credentials into a string.
+// The basic credentials keep the original base64 encoded 'username:::password' format,
+// the token based credentials and the custom headers are stored as JSON.
+func encodeCreds(creds types.Creds) string {
+	if creds.IsBasic() && len(creds.Headers) == 0 {
+		return strings.Join([]string{encodeb64(creds.Username), encodeb64(creds.Password)}, consts.CredSeparator)
+	}
+	data, err := json.Marshal(creds)
+	if err != nil {
+		log.Fatal().Msgf("unable to encode credentials:%v", err)
+	}
+	return string(data)
 }
 
 // encodeb64 encodes the string into a base64 encoded string.
@@ -17,9 +27,19 @@ func encodeb64(s string) string {
 	return base64.StdEncoding.EncodeToString([]byte(s))
 }
 
-// decodeCreds decodes the base64 encoded user credentials into a user credentials object.
+// decodeCreds decodes the encoded user credentials into a user credentials object.
 func decodeCreds(creds string) *types.Creds {
+	if strings.HasPrefix(creds, "{") {
+		result := new(types.Creds)
+		if err := json.Unmarshal([]byte(creds), result); err != nil {
+			log.Fatal().Msgf("unable to decode credentials:%v", err)
+		}
+		return result
+	}
 	parts := strings.Split(creds, consts.CredSeparator)
+	if len(parts) != 2 {
+		log.Fatal().Msg("unable to decode credentials: unknown format")
+	}
 	return &types.Creds{
 		Username: decodeb64(parts[0]),
 		Password: decodeb64(parts[1]),
@@ -30,7 +50,7 @@ func decodeCreds(creds string) *types.Creds {
 func decodeb64(s string) string {
 	data, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
-		log.Fatal().Err(err)
+		log.Fatal().Msgf("unable to decode credentials:%v", err)
 	}
 	return string(data)
 }
@@ -0,0 +1,145 @@
+package creds
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/consts"
+	"github.com/dalet-oss/opensearch-cli/pkg/types"
+	"github.com/zalando/go-keyring"
+	"maps"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ExecCachePrefix is the prefix of the keyring entries holding the cached exec credentials.
+const ExecCachePrefix = "exec"
+
+// ExecResponse represents the JSON document the credentials helper prints to stdout.
+// The helper returns either the username/password pair or the token, the optional expiry enables caching.
+type ExecResponse struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Token    string `json:"token,omitempty"`
+	// Expiry is the RFC3339 timestamp until which the credentials are valid.
+	Expiry *time.Time `json:"expiry,omitempty"`
+}
+
+// Validate checks that the response contains a complete set of credentials.
+func (r ExecResponse) Validate() error {
+	if r.Token != "" {
+		return nil
+	}
+	if r.Username == "" || r.Password == "" {
+		return errors.New("exec response must contain either 'token' or both 'username' and 'password'")
+	}
+	return nil
+}
+
+// Expired returns true if the response has an expiry and it has already passed.
+func (r ExecResponse) Expired(now time.Time) bool {
+	return r.Expiry != nil && !now.Before(*r.Expiry)
+}
+
+// Creds converts the response into the user credentials.
+func (r ExecResponse) Creds() *types.Creds {
+	return &types.Creds{Username: r.Username, Password: r.Password, Token: r.Token}
+}
+
+// GetDataFromExec runs the credentials helper command and parses the credentials from its stdout.
+// The helper inherits the environment of the cli extended with env, stdin and stderr are passed through so the helper can interact with the user.
+// Responses with an expiry are cached in the keyring until they expire, responses without it are requested on every call.
+func GetDataFromExec(command string, args []string, env map[string]string) (*types.Creds, error) {
+	cacheId := execCacheId(command, args, env)
+	if cached, ok := pullExecCache(cacheId); ok {
+		log.Debug().Msgf("using cached credentials of '%s'", command)
+		return cached.Creds(), nil
+	}
+	rsp, err := runExec(command, args, env)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.Expiry != nil {
+		pushExecCache(cacheId, rsp)
+	}
+	return rsp.Creds(), nil
+}
+
+// runExec runs the credentials helper command and parses its response.
+func runExec(command string, args []string, env map[string]string) (ExecResponse, error) {
+	var rsp ExecResponse
+	var stdout bytes.Buffer
+	cmd := exec.Command(command, args...)
+	cmd.Env = os.Environ()
+	for _, k := range slices.Sorted(maps.Keys(env)) {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, env[k]))
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return rsp, fmt.Errorf("credentials helper '%s' failed:%w", command, err)
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &rsp); err != nil {
+		return rsp, fmt.Errorf("unable to parse the response of the credentials helper '%s':%w", command, err)
+	}
+	if err := rsp.Validate(); err != nil {
+		return rsp, err
+	}
+	if rsp.Expired(time.Now()) {
+		return rsp, fmt.Errorf("credentials helper '%s' returned already expired credentials", command)
+	}
+	return rsp, nil
+}
+
+// execCacheId builds the keyring id of the cached credentials, unique for the command, its arguments and environment.
+func execCacheId(command string, args []string, env map[string]string) string {
+	hash := sha256.New()
+	hash.Write([]byte(command))
+	for _, arg := range args {
+		hash.Write([]byte{0})
+		hash.Write([]byte(arg))
+	}
+	for _, k := range slices.Sorted(maps.Keys(env)) {
+		hash.Write([]byte{0})
+		hash.Write([]byte(k + "=" + env[k]))
+	}
+	return strings.Join([]string{ExecCachePrefix, hex.EncodeToString(hash.Sum(nil))}, KeyringSeparator)
+}
+
+// pullExecCache returns the cached response if it is present in the keyring and not expired yet.
+func pullExecCache(id string) (ExecResponse, bool) {
+	var rsp ExecResponse
+	secret, err := keyring.Get(consts.ServiceName, id)
+	if err != nil {
+		return rsp, false
+	}
+	if err := json.Unmarshal([]byte(secret), &rsp); err != nil || rsp.Validate() != nil || rsp.Expiry == nil {
+		log.Debug().Msgf("dropping malformed cached credentials '%s'", id)
+		_ = keyring.Delete(consts.ServiceName, id)
+		return rsp, false
+	}
+	if rsp.Expired(time.Now()) {
+		_ = keyring.Delete(consts.ServiceName, id)
+		return rsp, false
+	}
+	return rsp, true
+}
+
+// pushExecCache stores the response in the keyring, the cache is best effort and failures are only logged.
+func pushExecCache(id string, rsp ExecResponse) {
+	data, err := json.Marshal(rsp)
+	if err != nil {
+		log.Debug().Msgf("unable to marshal credentials for caching:%v", err)
+		return
+	}
+	if err := keyring.Set(consts.ServiceName, id, string(data)); err != nil {
+		log.Debug().Msgf("unable to cache credentials in the keyring:%v", err)
+	}
+}
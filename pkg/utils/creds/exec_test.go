@@ -0,0 +1,107 @@
+package creds
+
+import (
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zalando/go-keyring"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestGetDataFromExec validates parsing of the credentials helper responses.
+func TestGetDataFromExec(t *testing.T) {
+	keyring.MockInit()
+	tests := []struct {
+		name     string
+		script   string
+		env      map[string]string
+		expected *types.Creds
+		wantErr  bool
+	}{
+		{
+			name:     "username and password",
+			script:   `echo '{"username":"admin","password":"secret"}'`,
+			expected: &types.Creds{Username: "admin", Password: "secret"},
+		},
+		{
+			name:     "token",
+			script:   `echo '{"token":"abc"}'`,
+			expected: &types.Creds{Token: "abc"},
+		},
+		{
+			name:     "environment is passed to the helper",
+			script:   `echo "{\"username\":\"$OSCLI_TEST_USER\",\"password\":\"secret\"}"`,
+			env:      map[string]string{"OSCLI_TEST_USER": "from-env"},
+			expected: &types.Creds{Username: "from-env", Password: "secret"},
+		},
+		{
+			name:    "password is missing",
+			script:  `echo '{"username":"admin"}'`,
+			wantErr: true,
+		},
+		{
+			name:    "not a json",
+			script:  `echo 'admin:secret'`,
+			wantErr: true,
+		},
+		{
+			name:    "helper fails",
+			script:  `exit 1`,
+			wantErr: true,
+		},
+		{
+			name:    "expired credentials",
+			script:  fmt.Sprintf(`echo '{"token":"abc","expiry":"%s"}'`, time.Now().Add(-time.Hour).Format(time.RFC3339)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GetDataFromExec("sh", []string{"-c", tt.script}, tt.env)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+// TestGetDataFromExecCache validates that only the credentials with the expiry are cached.
+func TestGetDataFromExecCache(t *testing.T) {
+	keyring.MockInit()
+	tests := []struct {
+		name          string
+		expiry        string
+		expectedCalls int
+	}{
+		{
+			name:          "no expiry",
+			expectedCalls: 3,
+		},
+		{
+			name:          "expiry in the future",
+			expiry:        fmt.Sprintf(`,"expiry":"%s"`, time.Now().Add(time.Hour).Format(time.RFC3339)),
+			expectedCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := filepath.Join(t.TempDir(), "calls")
+			script := fmt.Sprintf(`echo x >> %s; echo '{"token":"abc"%s}'`, counter, tt.expiry)
+			for range 3 {
+				result, err := GetDataFromExec("sh", []string{"-c", script}, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, "abc", result.Token)
+			}
+			calls, err := os.ReadFile(counter)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCalls, strings.Count(string(calls), "x"))
+		})
+	}
+}
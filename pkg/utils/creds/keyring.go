@@ -1,6 +1,7 @@
 package creds
 
 import (
+	"errors"
 	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/consts"
 	"github.com/dalet-oss/opensearch-cli/pkg/types"
@@ -12,9 +13,9 @@ import (
 const KeyringSeparator = ":::"
 
 // PushToKeyring stores the encoded user credentials in the system's keyring under a unique identifier and service name.
-func PushToKeyring(user, passw string) string {
-	id, creds := BuildKeyringPair(user, passw)
-	err := keyring.Set(consts.ServiceName, id, creds)
+func PushToKeyring(creds types.Creds) string {
+	id, encoded := BuildKeyringPair(creds)
+	err := keyring.Set(consts.ServiceName, id, encoded)
 	if err != nil {
 		log.Fatal().Msgf("unable to store data in the keyring:%v", err)
 	}
@@ -24,6 +25,10 @@ func PushToKeyring(user, passw string) string {
 // DeleteFromKeyring deletes the encoded user credentials from the system's keyring under a unique identifier and service name.'
 func DeleteFromKeyring(id string) {
 	err := keyring.Delete(consts.ServiceName, id)
+	if errors.Is(err, keyring.ErrNotFound) {
+		log.Warn().Msgf("keyring entry '%s' is not found, nothing to delete", id)
+		return
+	}
 	if err != nil {
 		log.Fatal().Msgf("unable to delete data from the keyring:%v", err)
 	}
@@ -31,19 +36,34 @@ func DeleteFromKeyring(id string) {
 
 // PullFromKeyring retrieves the encoded user credentials from the system's keyring under a unique identifier and service name.'
 func PullFromKeyring(id string) *types.Creds {
-	secretId := strings.Replace(id, fmt.Sprintf("%s%s", KeyringPrefix, KeyringSeparator), "", 1)
-	secret, err := keyring.Get(consts.ServiceName, secretId)
+	// the entries are stored under the full id by PushToKeyring, the bare uuid lookup is kept for the older entries
+	secret, err := keyring.Get(consts.ServiceName, id)
+	if errors.Is(err, keyring.ErrNotFound) {
+		secret, err = keyring.Get(consts.ServiceName, strings.Replace(id, fmt.Sprintf("%s%s", KeyringPrefix, KeyringSeparator), "", 1))
+	}
 	if err != nil {
-		log.Fatal().Err(err)
+		log.Fatal().Msgf("unable to get data from the keyring:%v", err)
 	}
 	return decodeCreds(secret)
 }
 
+// KeyringEntryExists checks whether the entry with the id is stored in the system's keyring, see PullFromKeyring.
+func KeyringEntryExists(id string) (bool, error) {
+	_, err := keyring.Get(consts.ServiceName, id)
+	if errors.Is(err, keyring.ErrNotFound) {
+		_, err = keyring.Get(consts.ServiceName, strings.Replace(id, fmt.Sprintf("%s%s", KeyringPrefix, KeyringSeparator), "", 1))
+	}
+	if errors.Is(err, keyring.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 // BuildKeyringPair builds a unique identifier and encoded user credentials pair.
-func BuildKeyringPair(username, password string) (id string, keyringEntry string) {
+func BuildKeyringPair(creds types.Creds) (id string, keyringEntry string) {
 	v7, err := uuid.NewV7()
 	if err != nil {
-		log.Fatal().Err(err)
+		log.Fatal().Msgf("unable to generate keyring id:%v", err)
 	}
-	return fmt.Sprintf("%s%s%s", KeyringPrefix, KeyringSeparator, v7.String()), encodeCreds(username, password)
+	return fmt.Sprintf("%s%s%s", KeyringPrefix, KeyringSeparator, v7.String()), encodeCreds(creds)
 }
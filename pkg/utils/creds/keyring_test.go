@@ -0,0 +1,59 @@
+package creds
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/consts"
+	"github.com/dalet-oss/opensearch-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zalando/go-keyring"
+	"net/http"
+	"testing"
+)
+
+// TestKeyringRoundTrip validates that all kinds of credentials are restored from the keyring as they were stored.
+func TestKeyringRoundTrip(t *testing.T) {
+	keyring.MockInit()
+	tests := []struct {
+		name   string
+		creds  types.Creds
+		header http.Header
+	}{
+		{
+			name:   "basic",
+			creds:  types.Creds{Username: "admin", Password: "p@ss:::word"},
+			header: http.Header{},
+		},
+		{
+			name:   "bearer token",
+			creds:  types.Creds{Token: "eyJhbGciOi.jwt"},
+			header: http.Header{"Authorization": []string{"Bearer eyJhbGciOi.jwt"}},
+		},
+		{
+			name:   "api key with custom headers",
+			creds:  types.Creds{APIKey: "a2V5", Headers: map[string]string{"x-proxy-tenant": "ops"}},
+			header: http.Header{"Authorization": []string{"ApiKey a2V5"}, "X-Proxy-Tenant": []string{"ops"}},
+		},
+		{
+			name:   "basic with custom headers",
+			creds:  types.Creds{Username: "admin", Password: "secret", Headers: map[string]string{"X-Request-Source": "cli"}},
+			header: http.Header{"X-Request-Source": []string{"cli"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := PushToKeyring(tt.creds)
+			restored := PullFromKeyring(id)
+			assert.Equal(t, tt.creds, *restored)
+			assert.Equal(t, tt.header, restored.Header())
+			DeleteFromKeyring(id)
+		})
+	}
+}
+
+// TestPullFromKeyringLegacyEntry validates that the entries stored in the original format are still readable.
+func TestPullFromKeyringLegacyEntry(t *testing.T) {
+	keyring.MockInit()
+	assert.NoError(t, keyring.Set(consts.ServiceName, "0199-legacy", encodeb64("admin")+consts.CredSeparator+encodeb64("secret")))
+	restored := PullFromKeyring(KeyringPrefix + KeyringSeparator + "0199-legacy")
+	assert.Equal(t, types.Creds{Username: "admin", Password: "secret"}, *restored)
+	assert.True(t, restored.IsBasic())
+}
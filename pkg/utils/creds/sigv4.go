@@ -0,0 +1,85 @@
+package creds
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/opensearch-project/opensearch-go/v4/signer"
+	"github.com/opensearch-project/opensearch-go/v4/signer/awsv2"
+	"slices"
+)
+
+const (
+	// SigV4ServiceES is the signing name of the Amazon OpenSearch Service domains.
+	SigV4ServiceES = "es"
+	// SigV4ServiceAOSS is the signing name of the Amazon OpenSearch Serverless collections.
+	SigV4ServiceAOSS = "aoss"
+	// DefaultRoleSessionName is used for the assumed role sessions when the session name is not configured.
+	DefaultRoleSessionName = "opensearch-cli"
+)
+
+// SigV4Services holds the list of supported signing names.
+var SigV4Services = []string{SigV4ServiceES, SigV4ServiceAOSS}
+
+// SigV4Options holds the parameters of the AWS SigV4 request signer.
+type SigV4Options struct {
+	// Region of the domain, required.
+	Region string
+	// Service is the signing name, es(default) or aoss.
+	Service string
+	// Profile is the name of the shared config profile, the default credentials chain is used if empty.
+	Profile string
+	// StaticKeysToken is the keyring id of the static access key id(username) and secret access key(password).
+	StaticKeysToken string
+	// RoleARN is the role assumed with the resolved credentials.
+	RoleARN string
+	// RoleSessionName is the session name of the assumed role.
+	RoleSessionName string
+}
+
+// NewSigV4Signer creates the signer which signs the OpenSearch requests with AWS SigV4.
+// The credentials are resolved from the static keys in the keyring, the profile or the default AWS credentials chain,
+// and exchanged for the role credentials if the role ARN is set.
+func NewSigV4Signer(ctx context.Context, opts SigV4Options) (signer.Signer, error) {
+	if opts.Region == "" {
+		return nil, fmt.Errorf("sigv4 region must be provided")
+	}
+	service := opts.Service
+	if service == "" {
+		service = SigV4ServiceES
+	}
+	if !slices.Contains(SigV4Services, service) {
+		return nil, fmt.Errorf("unsupported sigv4 service '%s', available services are: %v", service, SigV4Services)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	loadOpts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(opts.Region)}
+	if opts.Profile != "" {
+		loadOpts = append(loadOpts, awsconfig.WithSharedConfigProfile(opts.Profile))
+	}
+	if opts.StaticKeysToken != "" {
+		keys := PullFromKeyring(opts.StaticKeysToken)
+		loadOpts = append(loadOpts, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(keys.Username, keys.Password, "")))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, loadOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load aws config:%w", err)
+	}
+	if opts.RoleARN != "" {
+		sessionName := opts.RoleSessionName
+		if sessionName == "" {
+			sessionName = DefaultRoleSessionName
+		}
+		awsCfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(awsCfg), opts.RoleARN,
+			func(o *stscreds.AssumeRoleOptions) {
+				o.RoleSessionName = sessionName
+			}))
+	}
+	return awsv2.NewSignerWithService(awsCfg, service)
+}
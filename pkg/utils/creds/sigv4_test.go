@@ -0,0 +1,88 @@
+package creds
+
+import (
+	"context"
+	"github.com/dalet-oss/opensearch-cli/pkg/types"
+	"github.com/opensearch-project/opensearch-go/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/zalando/go-keyring"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestNewSigV4Signer validates that the requests sent through the OpenSearch client are signed with AWS SigV4.
+func TestNewSigV4Signer(t *testing.T) {
+	keyring.MockInit()
+	// the default credentials chain must not pick up the real credentials of the machine
+	t.Setenv("AWS_CONFIG_FILE", "/dev/null")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "/dev/null")
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDENV")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "env-secret")
+	staticKeysToken := PushToKeyring(types.Creds{Username: "AKIDSTATIC", Password: "static-secret"})
+
+	var authorization http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name                string
+		opts                SigV4Options
+		expectedCredentials string
+		wantErr             bool
+	}{
+		{
+			name:                "static keys from the keyring",
+			opts:                SigV4Options{Region: "eu-west-1", StaticKeysToken: staticKeysToken},
+			expectedCredentials: "Credential=AKIDSTATIC/",
+		},
+		{
+			name:                "default credentials chain",
+			opts:                SigV4Options{Region: "us-east-1", Service: SigV4ServiceAOSS},
+			expectedCredentials: "Credential=AKIDENV/",
+		},
+		{
+			name:    "region is missing",
+			opts:    SigV4Options{StaticKeysToken: staticKeysToken},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported service",
+			opts:    SigV4Options{Region: "eu-west-1", Service: "s3"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestSigner, err := NewSigV4Signer(context.Background(), tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			client, err := opensearch.NewClient(opensearch.Config{Addresses: []string{server.URL}, Signer: requestSigner})
+			assert.NoError(t, err)
+			req, err := http.NewRequest(http.MethodGet, "/", nil)
+			assert.NoError(t, err)
+			rsp, err := client.Perform(req)
+			assert.NoError(t, err)
+			_ = rsp.Body.Close()
+
+			service := tt.opts.Service
+			if service == "" {
+				service = SigV4ServiceES
+			}
+			header := authorization.Get("Authorization")
+			assert.True(t, strings.HasPrefix(header, "AWS4-HMAC-SHA256 "), "request must be signed with sigv4, got '%s'", header)
+			assert.Contains(t, header, tt.expectedCredentials)
+			assert.Contains(t, header, "/"+tt.opts.Region+"/"+service+"/aws4_request")
+			assert.NotEmpty(t, authorization.Get("X-Amz-Date"))
+			assert.NotEmpty(t, authorization.Get("X-Amz-Content-Sha256"))
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package flagutils
 
 import (
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
 	"github.com/spf13/pflag"
+	"io"
 	"slices"
 )
 
@@ -52,3 +54,52 @@ func GetStringFlagInSet(flagSet *pflag.FlagSet, flagName string, allowedValues [
 	}
 	return value
 }
+
+// GetStringArrayFlag retrieves the values of a repeatable string flag by name or terminates the program.
+func GetStringArrayFlag(flagSet *pflag.FlagSet, flagName string) []string {
+	value, err := flagSet.GetStringArray(flagName)
+	if err != nil {
+		log.Fatal().Msgf("failed to get flag %s from flagset: %v", flagName, err)
+	}
+	return value
+}
+
+// GetIntFlag retrieves the integer value of a flag by name or terminates the program.
+func GetIntFlag(flagSet *pflag.FlagSet, flagName string) int {
+	value, err := flagSet.GetInt(flagName)
+	if err != nil {
+		log.Fatal().Msgf("failed to get flag %s from flagset: %v", flagName, err)
+	}
+	return value
+}
+
+// GetStringSliceFlag retrieves the values of a comma separated list flag by name or terminates the program.
+func GetStringSliceFlag(flagSet *pflag.FlagSet, flagName string) []string {
+	value, err := flagSet.GetStringSlice(flagName)
+	if err != nil {
+		log.Fatal().Msgf("failed to get flag %s from flagset: %v", flagName, err)
+	}
+	return value
+}
+
+// GetDataFlag returns the value of the inline data flag or the content of the file given by the file flag('-' reads stdin),
+// nil if neither is set. The leading '~' of the file path is expanded to the user home directory.
+func GetDataFlag(flagSet *pflag.FlagSet, dataFlag, fileFlag string, stdin io.Reader) ([]byte, error) {
+	if flagSet.Changed(dataFlag) {
+		return []byte(GetStringFlag(flagSet, dataFlag)), nil
+	}
+	return GetFileFlag(flagSet, fileFlag, stdin)
+}
+
+// GetFileFlag returns the content of the file given by the file flag('-' reads stdin), nil if it is not set.
+// The leading '~' of the file path is expanded to the user home directory.
+func GetFileFlag(flagSet *pflag.FlagSet, fileFlag string, stdin io.Reader) ([]byte, error) {
+	switch file := GetStringFlag(flagSet, fileFlag); file {
+	case "":
+		return nil, nil
+	case "-":
+		return io.ReadAll(stdin)
+	default:
+		return generic.ReadFile(file)
+	}
+}
@@ -9,15 +9,32 @@ import "github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
 
 var log = logging.Logger()
 
+// ExpandHome replaces the leading '~' of the path with the user home directory.
+func ExpandHome(filename string) (string, error) {
+	if !strings.HasPrefix(filename, "~") {
+		return filename, nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, strings.TrimPrefix(filename[1:], "/")), nil
+}
+
+// ReadFile reads the content of the specified file, the leading '~' of the path is expanded to the user home directory.
+func ReadFile(filename string) ([]byte, error) {
+	filePath, err := ExpandHome(filename)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(filePath)
+}
+
 // SaveFile saves the data to the specified file. If the file does not exist, it will be created.
 func SaveFile(filename string, data string) error {
-	filePath := filename
-	if strings.HasPrefix(filename, "~") {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return err
-		}
-		filePath = filepath.Join(homeDir, filename[2:])
+	filePath, err := ExpandHome(filename)
+	if err != nil {
+		return err
 	}
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
 		mkdirErr := os.MkdirAll(filepath.Dir(filePath), 0755)
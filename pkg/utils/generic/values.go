@@ -0,0 +1,68 @@
+package generic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FormatValue returns the string representation of the flat setting value, the lists are comma separated.
+func FormatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			values = append(values, FormatValue(e))
+		}
+		return strings.Join(values, ",")
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// FlattenValues returns the values of the nested objects keyed by their dotted path, the values are formatted
+// by FormatValue, except the lists which are kept as JSON. The empty objects and the nulls are skipped.
+func FlattenValues(value any) map[string]string {
+	values := map[string]string{}
+	var walk func(prefix string, value any)
+	walk = func(prefix string, value any) {
+		switch v := value.(type) {
+		case nil:
+		case map[string]any:
+			for key, nested := range v {
+				walk(prefix+key+".", nested)
+			}
+		case []any:
+			b, _ := json.Marshal(v)
+			values[strings.TrimSuffix(prefix, ".")] = string(b)
+		default:
+			values[strings.TrimSuffix(prefix, ".")] = FormatValue(v)
+		}
+	}
+	walk("", value)
+	return values
+}
+
+// ParseKeyValues converts the key=value pairs to the settings, the empty value is converted to nil
+// which resets the setting to its default in the settings updates.
+func ParseKeyValues(pairs []string) (map[string]any, error) {
+	settings := map[string]any{}
+	for _, pair := range pairs {
+		k, v, found := strings.Cut(pair, "=")
+		k = strings.TrimSpace(k)
+		if !found || k == "" {
+			return nil, fmt.Errorf("setting '%s' must be in the form key=value", pair)
+		}
+		if v == "" {
+			settings[k] = nil
+		} else {
+			settings[k] = v
+		}
+	}
+	return settings, nil
+}
@@ -0,0 +1,35 @@
+package generic
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "", FormatValue(nil))
+	assert.Equal(t, "1s", FormatValue("1s"))
+	assert.Equal(t, "a,b", FormatValue([]any{"a", "b"}))
+	assert.Equal(t, "2", FormatValue(float64(2)))
+}
+
+func TestFlattenValues(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"a.b":   "1",
+		"a.c":   "true",
+		"a.d":   `["x","y"]`,
+		"e.f.g": "text",
+	}, FlattenValues(map[string]any{
+		"a": map[string]any{"b": float64(1), "c": true, "d": []any{"x", "y"}, "empty": map[string]any{}, "null": nil},
+		"e": map[string]any{"f": map[string]any{"g": "text"}},
+	}))
+}
+
+func TestParseKeyValues(t *testing.T) {
+	settings, err := ParseKeyValues([]string{"a.b=1", " c = x=y", "d="})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"a.b": "1", "c": " x=y", "d": nil}, settings)
+	_, err = ParseKeyValues([]string{"no-value"})
+	assert.EqualError(t, err, "setting 'no-value' must be in the form key=value")
+	_, err = ParseKeyValues([]string{"=1"})
+	assert.Error(t, err)
+}
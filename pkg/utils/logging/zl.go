@@ -8,8 +8,10 @@ import (
 	"time"
 )
 
+// Logger returns the console logger of the cli.
+// Logs are written to stderr, so they never mix with the command results printed to stdout.
 func Logger() zerolog.Logger {
-	output := zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}
+	output := zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}
 	output.FormatLevel = func(i interface{}) string {
 		return strings.ToUpper(fmt.Sprintf("| %-6s|", i))
 	}
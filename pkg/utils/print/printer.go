@@ -0,0 +1,194 @@
+package print
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/dalet-oss/opensearch-cli/pkg/consts"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/flagutils"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	// FormatTable renders the result as an aligned table(default).
+	FormatTable = "table"
+	// FormatWide renders the result as an aligned table with additional columns.
+	FormatWide = "wide"
+	// FormatJSON renders the result as indented JSON.
+	FormatJSON = "json"
+	// FormatYAML renders the result as YAML.
+	FormatYAML = "yaml"
+	// FormatCSV renders the result as comma separated values with a header row.
+	FormatCSV = "csv"
+	// FormatNDJSON renders the result as newline delimited JSON, every element of the list on its own line.
+	FormatNDJSON = "ndjson"
+)
+
+// OutputFormats holds the list of supported output formats.
+var OutputFormats = []string{FormatTable, FormatWide, FormatJSON, FormatYAML, FormatCSV, FormatNDJSON}
+
+// Tabular is implemented by results which know how to represent themselves as rows of a table.
+// Results which don't implement it are rendered as JSON in the table and wide formats.
+type Tabular interface {
+	// TableHeader returns the column names, wide requests the extended set of columns.
+	TableHeader(wide bool) []string
+	// TableRows returns the rows of the table, every row must have the same length as the header.
+	TableRows(wide bool) [][]string
+}
+
+// Printer renders command results in the requested output format.
+type Printer struct {
+	Format string
+	Out    io.Writer
+}
+
+// NewPrinter creates a new Printer writing to the given writer, an empty format falls back to the table format.
+func NewPrinter(format string, out io.Writer) *Printer {
+	if format == "" {
+		format = FormatTable
+	}
+	return &Printer{Format: format, Out: out}
+}
+
+// FromFlags creates a Printer writing to stdout using the output format requested on the command line.
+// The legacy '--raw' flag is treated as a shortcut for '--output json'.
+func FromFlags(flagSet *pflag.FlagSet) *Printer {
+	format := FormatTable
+	if flagSet.Lookup(consts.OutputFlag) != nil {
+		format = flagutils.GetStringFlagInSet(flagSet, consts.OutputFlag, OutputFormats)
+	}
+	if flagSet.Lookup(consts.RawFlag) != nil && flagutils.GetBoolFlag(flagSet, consts.RawFlag) {
+		format = FormatJSON
+	}
+	return NewPrinter(format, os.Stdout)
+}
+
+// IsStructured returns true if the printer produces machine-readable output(json, yaml or ndjson).
+func (p *Printer) IsStructured() bool {
+	return p.Format == FormatJSON || p.Format == FormatYAML || p.Format == FormatNDJSON
+}
+
+// Print renders the value in the configured format.
+func (p *Printer) Print(v any) error {
+	switch p.Format {
+	case FormatJSON:
+		return p.printJSON(v)
+	case FormatYAML:
+		return p.printYAML(v)
+	case FormatNDJSON:
+		return p.printNDJSON(v)
+	case FormatTable, FormatWide:
+		if t, ok := v.(Tabular); ok {
+			return p.printTable(t, p.Format == FormatWide)
+		}
+		return p.printJSON(v)
+	case FormatCSV:
+		if t, ok := v.(Tabular); ok {
+			return p.printCSV(t)
+		}
+		return fmt.Errorf("csv output is not supported for %T, use json or yaml instead", v)
+	}
+	return fmt.Errorf("unsupported output format '%s', available formats are: %s", p.Format, strings.Join(OutputFormats, ","))
+}
+
+// PrintOrDie renders the value in the configured format, logging a fatal error and exiting on failure.
+func (p *Printer) PrintOrDie(v any) {
+	if err := p.Print(v); err != nil {
+		log.Fatal().Msgf("unable to print result:%v", err)
+	}
+}
+
+// printJSON writes the value as indented JSON.
+func (p *Printer) printJSON(v any) error {
+	jsonBytes, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.Out, string(jsonBytes))
+	return err
+}
+
+// printNDJSON writes the elements of the slice or array as compact JSON lines, the other values as the single line.
+func (p *Printer) printNDJSON(v any) error {
+	items := []any{v}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		items = make([]any, rv.Len())
+		for i := range items {
+			items[i] = rv.Index(i).Interface()
+		}
+	}
+	encoder := json.NewEncoder(p.Out)
+	for _, item := range items {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printYAML writes the value as YAML.
+// The value is converted through JSON first, so the json tags of the API types are respected and the field order is preserved.
+func (p *Printer) printYAML(v any) error {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(jsonBytes, &node); err != nil {
+		return err
+	}
+	resetStyle(&node)
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	_, err = p.Out.Write(buf.Bytes())
+	return err
+}
+
+// resetStyle drops the flow(JSON-like) style inherited from the JSON source, so the encoder emits block YAML.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// printTable writes the tabular value as an aligned table.
+func (p *Printer) printTable(t Tabular, wide bool) error {
+	w := tabwriter.NewWriter(p.Out, 0, 0, 3, ' ', 0)
+	header := t.TableHeader(wide)
+	if _, err := fmt.Fprintln(w, strings.ToUpper(strings.Join(header, "\t"))); err != nil {
+		return err
+	}
+	for _, row := range t.TableRows(wide) {
+		if _, err := fmt.Fprintln(w, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// printCSV writes the tabular value as CSV, the wide set of columns is always used.
+func (p *Printer) printCSV(t Tabular) error {
+	w := csv.NewWriter(p.Out)
+	if err := w.Write(t.TableHeader(true)); err != nil {
+		return err
+	}
+	if err := w.WriteAll(t.TableRows(true)); err != nil {
+		return err
+	}
+	return w.Error()
+}
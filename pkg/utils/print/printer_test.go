@@ -0,0 +1,125 @@
+package print
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type testRow struct {
+	Name  string `json:"name"`
+	Count string `json:"count"`
+	Extra string `json:"extra"`
+}
+
+type testTable []testRow
+
+func (l testTable) TableHeader(wide bool) []string {
+	if wide {
+		return []string{"name", "count", "extra"}
+	}
+	return []string{"name", "count"}
+}
+
+func (l testTable) TableRows(wide bool) [][]string {
+	rows := [][]string{}
+	for _, r := range l {
+		row := []string{r.Name, r.Count}
+		if wide {
+			row = append(row, r.Extra)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// TestPrinter validates rendering of the tabular and plain values in all supported output formats.
+func TestPrinter(t *testing.T) {
+	table := testTable{{Name: "a", Count: "1", Extra: "x"}, {Name: "bb", Count: "22", Extra: "y,z"}}
+	tests := []struct {
+		name      string
+		format    string
+		value     any
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "table",
+			format:   FormatTable,
+			value:    table,
+			expected: "NAME   COUNT\na      1\nbb     22\n",
+		},
+		{
+			name:     "empty format falls back to table",
+			format:   "",
+			value:    table,
+			expected: "NAME   COUNT\na      1\nbb     22\n",
+		},
+		{
+			name:     "wide",
+			format:   FormatWide,
+			value:    table,
+			expected: "NAME   COUNT   EXTRA\na      1       x\nbb     22      y,z\n",
+		},
+		{
+			name:     "csv",
+			format:   FormatCSV,
+			value:    table,
+			expected: "name,count,extra\na,1,x\nbb,22,\"y,z\"\n",
+		},
+		{
+			name:     "json",
+			format:   FormatJSON,
+			value:    testRow{Name: "a", Count: "1"},
+			expected: "{\n    \"name\": \"a\",\n    \"count\": \"1\",\n    \"extra\": \"\"\n}\n",
+		},
+		{
+			name:     "yaml",
+			format:   FormatYAML,
+			value:    testTable{{Name: "a", Count: "1"}},
+			expected: "- name: a\n  count: \"1\"\n  extra: \"\"\n",
+		},
+		{
+			name:     "ndjson",
+			format:   FormatNDJSON,
+			value:    testTable{{Name: "a", Count: "1"}, {Name: "bb", Count: "22"}},
+			expected: "{\"name\":\"a\",\"count\":\"1\",\"extra\":\"\"}\n{\"name\":\"bb\",\"count\":\"22\",\"extra\":\"\"}\n",
+		},
+		{
+			name:     "ndjson of the single value",
+			format:   FormatNDJSON,
+			value:    testRow{Name: "a", Count: "1"},
+			expected: "{\"name\":\"a\",\"count\":\"1\",\"extra\":\"\"}\n",
+		},
+		{
+			name:     "table falls back to json for non tabular values",
+			format:   FormatTable,
+			value:    map[string]int{"a": 1},
+			expected: "{\n    \"a\": 1\n}\n",
+		},
+		{
+			name:      "csv is not supported for non tabular values",
+			format:    FormatCSV,
+			value:     map[string]int{"a": 1},
+			expectErr: true,
+		},
+		{
+			name:      "unknown format",
+			format:    "xml",
+			value:     table,
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			err := NewPrinter(tt.format, out).Print(tt.value)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package print
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// progressWidth is the number of characters of the progress bar.
+	progressWidth = 30
+	// progressInterval is the minimal interval between the progress renderings.
+	progressInterval = 100 * time.Millisecond
+)
+
+// Progress renders the single line progress of the long-running command on stderr.
+// Nothing is rendered if stderr is not a terminal, so the progress doesn't pollute the logs and redirected output.
+type Progress struct {
+	Label string
+	// Total is the expected final value of the progress, the bar is rendered only if it is set.
+	Total int64
+
+	out     io.Writer
+	enabled bool
+	mu      sync.Mutex
+	last    time.Time
+}
+
+// NewProgress creates the progress with the label and the expected total, 0 if the total is unknown.
+func NewProgress(label string, total int64) *Progress {
+	return &Progress{Label: label, Total: total, out: os.Stderr, enabled: IsTerminal(os.Stderr)}
+}
+
+// IsTerminal returns true if the file is a terminal.
+func IsTerminal(f *os.File) bool {
+	stat, err := f.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
+
+// Update renders the current value with the details, the rendering is throttled, it is safe for the concurrent use.
+func (p *Progress) Update(current int64, details string) {
+	if !p.enabled {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if time.Since(p.last) < progressInterval {
+		return
+	}
+	p.last = time.Now()
+	p.render(current, details)
+}
+
+// Finish renders the final value with the details and ends the progress line.
+func (p *Progress) Finish(current int64, details string) {
+	if !p.enabled {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.render(current, details)
+	_, _ = fmt.Fprintln(p.out)
+}
+
+// render overwrites the progress line.
+func (p *Progress) render(current int64, details string) {
+	line := p.Label
+	if p.Total > 0 {
+		ratio := min(float64(current)/float64(p.Total), 1)
+		filled := int(ratio * progressWidth)
+		line = fmt.Sprintf("%s [%s%s] %3.0f%%",
+			line, strings.Repeat("=", filled), strings.Repeat(" ", progressWidth-filled), ratio*100)
+	}
+	// \033[K clears the rest of the previous, possibly longer, line
+	_, _ = fmt.Fprintf(p.out, "\r%s %s\033[K", line, details)
+}
@@ -0,0 +1,30 @@
+package print
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestProgress(t *testing.T) {
+	out := &bytes.Buffer{}
+	p := &Progress{Label: "import", Total: 200, out: out, enabled: true}
+	p.Update(50, "50 docs")
+	assert.Equal(t, "\rimport [=======                       ]  25% 50 docs\033[K", out.String())
+	out.Reset()
+	p.Update(100, "100 docs")
+	assert.Empty(t, out.String(), "rendering must be throttled")
+	p.Finish(400, "done")
+	assert.Equal(t, "\rimport [==============================] 100% done\033[K\n", out.String())
+
+	out.Reset()
+	unknown := &Progress{Label: "export", out: out, enabled: true}
+	unknown.Finish(10, "10 docs")
+	assert.Equal(t, "\rexport 10 docs\033[K\n", out.String())
+
+	out.Reset()
+	disabled := &Progress{Label: "export", out: out}
+	disabled.Update(1, "1 doc")
+	disabled.Finish(1, "1 doc")
+	assert.Empty(t, out.String(), "nothing is rendered if the output is not a terminal")
+}
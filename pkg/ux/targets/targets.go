@@ -0,0 +1,52 @@
+package targets
+
+import (
+	"github.com/dalet-oss/opensearch-cli/pkg/api"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/fp"
+	gu "github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
+	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"slices"
+	"sort"
+	"strings"
+)
+
+var log = logging.Logger()
+
+// SelectIndices returns the sorted names of the indices selected by the argument, the index name or the pattern,
+// or by the interactive prompt with the label if there's no argument. The missing index terminates the program,
+// returns nil if the pattern matches nothing.
+func SelectIndices(client *api.OpensearchWrapper, args []string, label string) []string {
+	registeredIndices, indexListErr := client.GetIndexList()
+	if indexListErr != nil {
+		log.Fatal().Msgf("failed to get indices:%v", indexListErr)
+	}
+	indexNames := fp.Map(registeredIndices, func(info api.IndexInfo) string {
+		return info.Index
+	})
+	sort.Strings(indexNames)
+	if len(args) == 0 || args[0] == "" {
+		return []string{prompts.SelectivePrompt(label, indexNames)}
+	} else if !gu.ContainsWildcard(args[0]) {
+		if !slices.Contains(indexNames, args[0]) {
+			log.Fatal().Msgf("❌index '%s' not found", args[0])
+		}
+		return []string{args[0]}
+	}
+	filtered := MatchIndices(indexNames, args[0])
+	if len(filtered) == 0 {
+		log.Warn().Msgf("no indices found for %s expression [total %d in the cluster]", args[0], len(indexNames))
+		return nil
+	}
+	log.Info().Msgf(
+		"found %d %s for %s expression:\n%s",
+		len(filtered), fp.Ternary("index", "indices", len(filtered) == 1), args[0], strings.Join(filtered, "\n"))
+	return filtered
+}
+
+// MatchIndices returns the sorted names of the indices compliant with the pattern.
+func MatchIndices(indexNames []string, pattern string) []string {
+	filtered := fp.Filter(indexNames, gu.GetMatchFunc(pattern))
+	sort.Strings(filtered)
+	return filtered
+}
@@ -0,0 +1,25 @@
+package targets
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMatchIndices(t *testing.T) {
+	indexNames := []string{"logs-2", "orders", "logs-1", "dr-logs-1", ".logs-internal"}
+	tests := []struct {
+		pattern  string
+		expected []string
+	}{
+		{pattern: "logs-*", expected: []string{"logs-1", "logs-2"}},
+		{pattern: "*logs-1", expected: []string{"dr-logs-1", "logs-1"}},
+		{pattern: "*logs*", expected: []string{".logs-internal", "dr-logs-1", "logs-1", "logs-2"}},
+		{pattern: "*", expected: []string{".logs-internal", "dr-logs-1", "logs-1", "logs-2", "orders"}},
+		{pattern: "metrics-*", expected: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MatchIndices(indexNames, tt.pattern))
+		})
+	}
+}
@@ -3,10 +3,12 @@ package userconfig
 import (
 	"fmt"
 	"github.com/dalet-oss/opensearch-cli/pkg/appconfig"
+	"github.com/dalet-oss/opensearch-cli/pkg/types"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/creds"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/generic"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/logging"
 	"github.com/dalet-oss/opensearch-cli/pkg/utils/prompts"
+	"strings"
 )
 
 var log = logging.Logger()
@@ -14,6 +16,10 @@ var log = logging.Logger()
 const (
 	VaultBackend   = "vault"
 	KeyringBackend = "keyring"
+	ExecBackend    = "exec"
+	SigV4Backend   = "sigv4"
+	// NoneBackend is offered only for the clusters authenticating users by the client certificate.
+	NoneBackend = "none(client certificate)"
 )
 
 // CreateUserEntry creates a new user configuration by prompting for input and selecting a credential backend.
@@ -28,37 +34,76 @@ func CreateUserEntry(conf appconfig.AppConfig, cluster appconfig.ClusterConfig)
 		}
 		return nil
 	})
-	switch prompts.SelectivePrompt("Choose credential backend", []string{VaultBackend, KeyringBackend}) {
+	backends := []string{VaultBackend, KeyringBackend, ExecBackend, SigV4Backend}
+	if cluster.Params.HasClientCertificate() {
+		backends = append(backends, NoneBackend)
+	}
+	switch prompts.SelectivePrompt("Choose credential backend", backends) {
 	case VaultBackend:
 		user.User = appconfig.User{
 			Vault: getDataForVault(),
 		}
 	case KeyringBackend:
-		username, pass := getDataForKeyring()
+		keyringCreds := getDataForKeyring()
 		if user.Name == "" {
-			user.Name = fmt.Sprintf("%s@%s", username, cluster.Name)
+			user.Name = fmt.Sprintf("%s@%s", keyringPrincipal(keyringCreds), cluster.Name)
+		}
+		user.User = appconfig.User{
+			Token: creds.PushToKeyring(keyringCreds),
 		}
+	case ExecBackend:
 		user.User = appconfig.User{
-			Token: creds.PushToKeyring(username, pass),
+			Exec: getDataForExec(),
+		}
+	case SigV4Backend:
+		user.User = appconfig.User{
+			SigV4: getDataForSigV4(),
 		}
 	}
 	return user
 }
 
-// getDataForKeyring prompts the user to input a username and password, returning them as two separate strings.
-func getDataForKeyring() (string, string) {
-	username := ""
-	if userName := prompts.ValidatedPrompt("Username", func(input string) error {
-		if len(input) == 0 {
-			return fmt.Errorf("username is required")
+// getDataForKeyring prompts the user to select the authentication mode and input the matching credentials,
+// optionally followed by the custom headers which are stored in the keyring together with the credentials.
+func getDataForKeyring() types.Creds {
+	const basicAuth = "basic"
+	const bearerAuth = "bearer token"
+	const apiKeyAuth = "api key"
+	keyringCreds := types.Creds{}
+	switch prompts.SelectivePrompt("Authentication mode", []string{basicAuth, bearerAuth, apiKeyAuth}) {
+	case basicAuth:
+		if userName := prompts.ValidatedPrompt("Username", func(input string) error {
+			if len(input) == 0 {
+				return fmt.Errorf("username is required")
+			}
+			return nil
+		}); len(userName) == 0 {
+			log.Fatal().Msg("Username is required")
+		} else {
+			keyringCreds.Username = userName
 		}
-		return nil
-	}); len(userName) == 0 {
-		log.Fatal().Msg("Username is required")
-	} else {
-		username = userName
+		keyringCreds.Password = prompts.SecretPrompt("Password")
+	case bearerAuth:
+		keyringCreds.Token = prompts.SecretPrompt("Bearer token")
+	case apiKeyAuth:
+		keyringCreds.APIKey = prompts.SecretPrompt("API key")
+	}
+	keyringCreds.Headers = promptKeyValues("(optional)Custom header Name: value(empty to finish)", ":")
+	return keyringCreds
+}
+
+// keyringPrincipal returns the name of the keyring credentials principal used in the default user entry name,
+// the credentials without the username are named by the authentication type.
+func keyringPrincipal(keyringCreds types.Creds) string {
+	switch {
+	case keyringCreds.Username != "":
+		return keyringCreds.Username
+	case keyringCreds.Token != "":
+		return "token"
+	case keyringCreds.APIKey != "":
+		return "apikey"
 	}
-	return username, prompts.SecretPrompt("Password")
+	return "user"
 }
 
 // getDataForVault prompts the user to select between creating a new vault or binding to an existing one and returns its config.
@@ -101,3 +146,61 @@ func getDataForVault() *appconfig.VaultConfig {
 	}
 	return nil
 }
+
+// getDataForExec prompts the user for the credentials helper command, its arguments and environment,
+// then runs the helper once to check that it returns valid credentials.
+func getDataForExec() *appconfig.CredentialsExec {
+	execConfig := &appconfig.CredentialsExec{
+		Cmd:  prompts.ValidatedPrompt("Credentials helper command", prompts.NotEmptyString),
+		Args: strings.Fields(prompts.SimplePrompt("Command arguments, space separated(optional)")),
+	}
+	execConfig.Env = promptKeyValues("Environment variable KEY=VALUE(empty to finish)", "=")
+	if _, err := creds.GetDataFromExec(execConfig.Cmd, execConfig.Args, execConfig.Env); err != nil {
+		log.Warn().Msgf("credentials helper check failed, verify the command before using the context:%v", err)
+	} else {
+		log.Info().Msg("credentials helper returned valid credentials")
+	}
+	return execConfig
+}
+
+// getDataForSigV4 prompts the user for the region, service and the source of the AWS credentials.
+// The static keys are stored in the keyring, the same way as the keyring backend credentials.
+func getDataForSigV4() *appconfig.SigV4Config {
+	const defaultChain = "default credentials chain"
+	const profile = "profile"
+	const staticKeys = "static keys"
+	sigV4Config := &appconfig.SigV4Config{
+		Region:  prompts.ValidatedPrompt("AWS region", prompts.NotEmptyString),
+		Service: prompts.SelectivePrompt("Service", creds.SigV4Services),
+	}
+	switch prompts.SelectivePrompt("AWS credentials source", []string{defaultChain, profile, staticKeys}) {
+	case profile:
+		sigV4Config.Profile = prompts.ValidatedPrompt("AWS profile", prompts.NotEmptyString)
+	case staticKeys:
+		accessKeyId := prompts.ValidatedPrompt("Access key id", prompts.NotEmptyString)
+		sigV4Config.Token = creds.PushToKeyring(types.Creds{Username: accessKeyId, Password: prompts.SecretPrompt("Secret access key")})
+	}
+	sigV4Config.RoleARN = prompts.SimplePrompt("(optional)Role ARN to assume")
+	return sigV4Config
+}
+
+// promptKeyValues prompts for the key-value pairs separated by sep until the empty input, nil is returned if no pairs are entered.
+func promptKeyValues(label, sep string) map[string]string {
+	var result map[string]string
+	for {
+		entry := prompts.ValidatedPrompt(label, func(input string) error {
+			if len(input) > 0 && !strings.Contains(input, sep) {
+				return fmt.Errorf("value must be in KEY%sVALUE format", sep)
+			}
+			return nil
+		})
+		if len(entry) == 0 {
+			return result
+		}
+		if result == nil {
+			result = make(map[string]string)
+		}
+		key, value, _ := strings.Cut(entry, sep)
+		result[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+}